@@ -6,16 +6,35 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
 	"github.com/EugeneTsydenov/chesshub-user-service/cmd/user/app/grpcinterceptors"
 	"github.com/EugeneTsydenov/chesshub-user-service/cmd/user/app/tracker"
 	"github.com/EugeneTsydenov/chesshub-user-service/config"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/jobs"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/outbox"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/usecase"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/controllers/grpccontrollers"
+	userproto "github.com/EugeneTsydenov/chesshub-user-service/internal/controllers/grpccontrollers/genproto"
 	"github.com/EugeneTsydenov/chesshub-user-service/internal/controllers/grpccontrollers/interceptor"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/services"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/services/namepolicy"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/services/rating"
 	"github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/postgres"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/postgres/migrations"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/postgres/repo"
 	"github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/redis"
+	redisrepo "github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/redis/repo"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/events"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/hasher"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/mail"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/ratelimit"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
@@ -23,6 +42,8 @@ import (
 
 var shutdownTimeout = 30 * time.Second
 
+const leaderboardPrefix = "leaderboard"
+
 type Shutdowner interface {
 	Shutdown(ctx context.Context) error
 }
@@ -36,6 +57,10 @@ type App struct {
 	redisDatabase *redis.Database
 	database      *postgres.Database
 
+	userCache      *redisrepo.CachedUserRepo
+	placement      *user.Placement
+	userController *grpccontrollers.UserController
+
 	gRPCServer *grpc.Server
 
 	shutdownCh  chan struct{}
@@ -68,6 +93,24 @@ func (a *App) InitDeps(ctx context.Context) error {
 		return err
 	}
 
+	if err := a.initPlacement(); err != nil {
+		a.logger.Error(err)
+
+		return err
+	}
+
+	if err := a.initControllers(); err != nil {
+		a.logger.Error(err)
+
+		return err
+	}
+
+	if err := a.initWorkers(); err != nil {
+		a.logger.Error(err)
+
+		return err
+	}
+
 	return nil
 }
 
@@ -102,9 +145,430 @@ func (a *App) initPgDatabase(ctx context.Context) error {
 	a.database = d
 	a.RegisterShutdowner(d)
 
+	if a.config.Database.AutoMigrate {
+		return a.migrateUp(ctx)
+	}
+
 	return nil
 }
 
+func (a *App) migrateUp(ctx context.Context) error {
+	m, err := migrations.New(a.database)
+	if err != nil {
+		return err
+	}
+
+	applied, err := m.Up(ctx)
+	for _, migration := range applied {
+		a.logger.Infof("Applied migration %04d_%s", migration.Version, migration.Name)
+	}
+
+	return err
+}
+
+func (a *App) migrateDown(ctx context.Context, steps int) error {
+	m, err := migrations.New(a.database)
+	if err != nil {
+		return err
+	}
+
+	reverted, err := m.Down(ctx, steps)
+	for _, migration := range reverted {
+		a.logger.Infof("Reverted migration %04d_%s", migration.Version, migration.Name)
+	}
+
+	return err
+}
+
+// Migrate runs the migrate subcommand: "up" applies pending migrations, "down [steps]" reverts them.
+func (a *App) Migrate(ctx context.Context, args []string) error {
+	d, err := postgres.New(ctx, a.config.Database.DSN())
+	if err != nil {
+		return err
+	}
+	defer d.Pool().Close()
+
+	a.database = d
+
+	direction := "up"
+	if len(args) > 0 {
+		direction = args[0]
+	}
+
+	switch direction {
+	case "up":
+		return a.migrateUp(ctx)
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				return fmt.Errorf("invalid number of steps: %s", args[1])
+			}
+		}
+
+		return a.migrateDown(ctx, steps)
+	default:
+		return fmt.Errorf("unknown migrate direction: %s", direction)
+	}
+}
+
+// RebuildLeaderboards runs the leaderboard rebuild subcommand, copying every rating from Postgres into Redis.
+func (a *App) RebuildLeaderboards(ctx context.Context) error {
+	// Whatever was opened is closed in reverse order, also when a later step fails, as Shutdown does.
+	defer func() {
+		for i := len(a.shutdowners) - 1; i >= 0; i-- {
+			if err := a.shutdowners[i].Shutdown(ctx); err != nil {
+				a.logger.Error("Error shutting down component", "error", err)
+			}
+		}
+	}()
+
+	if err := a.initRedisCache(ctx); err != nil {
+		return err
+	}
+
+	if err := a.initPgDatabase(ctx); err != nil {
+		return err
+	}
+
+	if err := a.initPlacement(); err != nil {
+		return err
+	}
+
+	rebuild := usecase.NewRebuildLeaderboards(
+		redisrepo.NewRedisLeaderboard(a.redisDatabase, leaderboardPrefix),
+		repo.NewPostgresRatingRepository(a.database, a.placement),
+	)
+
+	output, err := rebuild.Execute(ctx, struct{}{})
+	if output != nil {
+		for timeControl, count := range output.Rebuilt {
+			a.logger.Infof("Rebuilt %s leaderboard with %d ratings", timeControl, count)
+		}
+	}
+
+	return err
+}
+
+// initPlacement reads the placement period, rejecting time controls that do not exist.
+func (a *App) initPlacement() error {
+	games := make(map[enums.TimeControl]int, len(a.config.Placement.Games))
+
+	for name, count := range a.config.Placement.Games {
+		timeControl := enums.TimeControl(name)
+		if !timeControl.IsValid() || count < 0 {
+			return fmt.Errorf("invalid placement games for time control %q: %d", name, count)
+		}
+
+		games[timeControl] = count
+	}
+
+	a.placement = user.NewPlacement(games, a.config.Placement.Volatility)
+
+	return nil
+}
+
+func (a *App) initControllers() error {
+	mailSender, err := mail.NewLogSender(a.config.Mail.From, a.config.Mail.FilePath)
+	if err != nil {
+		return err
+	}
+
+	a.RegisterShutdowner(mailSender)
+
+	// Credential checks read the primary repository, the cache holds no password hashes and may lag behind.
+	primaryUserRepo := repo.NewPostgresSessionRepository(a.database, postgres.NewUserQueryFactory())
+
+	a.userCache = redisrepo.NewCachedUserRepository(primaryUserRepo, a.redisDatabase, a.config.Cache.UserTTL)
+	userRepo := a.userCache
+	profileRepo := repo.NewPostgresProfileRepository(a.database)
+	ratingRepo := repo.NewPostgresRatingRepository(a.database, a.placement)
+	outboxRepo := repo.NewPostgresOutboxRepository(a.database)
+	moderationRepo := repo.NewPostgresModerationRepository(a.database)
+	tagHistoryRepo := repo.NewPostgresTagHistoryRepository(a.database)
+	seasonRepo := repo.NewPostgresSeasonRepository(a.database, a.placement)
+	headToHeadRepo := repo.NewPostgresHeadToHeadRepository(a.database)
+	leaderboard := redisrepo.NewRedisLeaderboard(a.redisDatabase, leaderboardPrefix)
+	verificationTokenRepo := redisrepo.NewRedisTokenRepository(a.redisDatabase, "email_verification")
+	passwordResetTokenRepo := redisrepo.NewRedisTokenRepository(a.redisDatabase, "password_reset")
+	passwordResetLimiter := ratelimit.NewFixedWindowLimiter(
+		a.redisDatabase,
+		"password_reset_limit",
+		a.config.PasswordReset.RateLimit,
+		a.config.PasswordReset.RateWindow,
+	)
+	passwordHasher := hasher.New(bcrypt.DefaultCost)
+
+	reserved := a.config.NamePolicy.Reserved
+	if len(reserved) == 0 {
+		reserved = namepolicy.DefaultReserved
+	}
+
+	namePolicy := namepolicy.New(reserved, a.config.NamePolicy.Profanity, a.config.NamePolicy.Allowed)
+
+	userService := services.NewUserService(userRepo, profileRepo, tagHistoryRepo, namePolicy)
+
+	sendEmailVerification := usecase.NewSendEmailVerification(
+		userRepo,
+		verificationTokenRepo,
+		mailSender,
+		a.config.Verification.TokenTTL,
+		a.config.Verification.ConfirmURL,
+	)
+
+	a.userController = grpccontrollers.NewUserController(&grpccontrollers.UseCases{
+		RegisterUser: usecase.NewRegisterUser(
+			a.database,
+			passwordHasher,
+			userService,
+			outboxRepo,
+			sendEmailVerification,
+			namePolicy,
+		),
+		VerifyCredentials:     usecase.NewVerifyCredentials(userRepo, primaryUserRepo, passwordHasher),
+		SendEmailVerification: sendEmailVerification,
+		ConfirmEmail:          usecase.NewConfirmEmail(a.database, userRepo, verificationTokenRepo, outboxRepo),
+		RequestPasswordReset: usecase.NewRequestPasswordReset(
+			primaryUserRepo,
+			passwordResetTokenRepo,
+			mailSender,
+			passwordResetLimiter,
+			a.config.PasswordReset.TokenTTL,
+			a.config.PasswordReset.ResetURL,
+		),
+		ConfirmPasswordReset: usecase.NewConfirmPasswordReset(a.database, userRepo, passwordResetTokenRepo, passwordHasher),
+		ChangePassword:       usecase.NewChangePassword(a.database, userRepo, passwordResetTokenRepo, passwordHasher),
+		RecordGameResult: usecase.NewRecordGameResult(
+			a.database,
+			userRepo,
+			ratingRepo,
+			headToHeadRepo,
+			leaderboard,
+			outboxRepo,
+			rating.NewEngine(rating.DefaultTau, a.placement),
+		),
+		GetRatings:               usecase.NewGetRatings(userRepo, ratingRepo),
+		GetLeaderboard:           usecase.NewGetLeaderboard(leaderboard, ratingRepo),
+		GetLeaderboardAroundUser: usecase.NewGetLeaderboardAroundUser(leaderboard, ratingRepo),
+		GetUserRank:              usecase.NewGetUserRank(leaderboard),
+		GetPublicProfiles:        usecase.NewGetPublicProfiles(profileRepo),
+		UpdateProfile:            usecase.NewUpdateProfile(a.database, profileRepo, outboxRepo, namePolicy),
+		SuspendUser:              usecase.NewSuspendUser(a.database, userRepo, moderationRepo, outboxRepo),
+		BanUser:                  usecase.NewBanUser(a.database, userRepo, moderationRepo, leaderboard, outboxRepo),
+		ReinstateUser: usecase.NewReinstateUser(
+			a.database,
+			userRepo,
+			moderationRepo,
+			ratingRepo,
+			leaderboard,
+			outboxRepo,
+		),
+		GetModerationLog: usecase.NewGetModerationLog(userRepo, moderationRepo),
+		DeleteUser: usecase.NewDeleteUser(
+			a.database,
+			userRepo,
+			leaderboard,
+			outboxRepo,
+			a.config.Deletion.GracePeriod,
+		),
+		RestoreUser: usecase.NewRestoreUser(
+			a.database,
+			userRepo,
+			ratingRepo,
+			leaderboard,
+			outboxRepo,
+			a.config.Deletion.GracePeriod,
+		),
+		ExportUserData:   usecase.NewExportUserData(userRepo, profileRepo, ratingRepo, moderationRepo),
+		GrantPremium:     usecase.NewGrantPremium(a.database, userRepo, outboxRepo),
+		RevokePremium:    usecase.NewRevokePremium(a.database, userRepo, outboxRepo),
+		GetPremiumStatus: usecase.NewGetPremiumStatus(userRepo),
+		GetUser:          usecase.NewGetUser(userRepo),
+		UpdateUser:       usecase.NewUpdateUser(a.database, userRepo, outboxRepo, sendEmailVerification),
+		GetProfile:       usecase.NewGetProfile(profileRepo),
+		GetUserByTag:     usecase.NewGetUserByTag(userRepo, tagHistoryRepo),
+		GetRatingChart: usecase.NewGetRatingChart(
+			userRepo,
+			redisrepo.NewCachedRatingChartRepository(ratingRepo, a.redisDatabase, a.config.Cache.RatingChartTTL),
+		),
+		AdjustRating:       usecase.NewAdjustRating(a.database, userRepo, ratingRepo, leaderboard, outboxRepo),
+		PenalizeRating:     usecase.NewPenalizeRating(a.database, userRepo, ratingRepo, leaderboard, outboxRepo),
+		RefundOpponents:    usecase.NewRefundOpponents(a.database, userRepo, ratingRepo, leaderboard, outboxRepo),
+		StartSeason:        usecase.NewStartSeason(a.database, seasonRepo),
+		GetSeasonStandings: usecase.NewGetSeasonStandings(seasonRepo),
+		GetHeadToHead:      usecase.NewGetHeadToHead(userRepo, headToHeadRepo),
+		GetTopOpponents:    usecase.NewGetTopOpponents(userRepo, headToHeadRepo),
+		ChangeTag: usecase.NewChangeTag(
+			a.database,
+			userRepo,
+			userService,
+			tagHistoryRepo,
+			outboxRepo,
+			a.config.Tag.ChangeCooldown,
+			a.config.Tag.AliasTTL,
+		),
+	})
+
+	return nil
+}
+
+func (a *App) initWorkers() error {
+	publisher, err := a.newEventPublisher()
+	if err != nil {
+		return err
+	}
+
+	relay := outbox.NewRelay(
+		a.database,
+		repo.NewPostgresOutboxRepository(a.database),
+		publisher,
+		a.logger,
+		outbox.RelayConfig{
+			PollInterval: a.config.Outbox.PollInterval,
+			BatchSize:    a.config.Outbox.BatchSize,
+			MaxBackoff:   a.config.Outbox.MaxBackoff,
+			Retention:    a.config.Outbox.Retention,
+			CommitLag:    a.config.Outbox.CommitLag,
+		},
+	)
+
+	relay.Start()
+	a.RegisterShutdowner(relay)
+
+	liftExpiredSuspensions := usecase.NewLiftExpiredSuspensions(
+		a.database,
+		a.userCache,
+		repo.NewPostgresModerationRepository(a.database),
+		repo.NewPostgresOutboxRepository(a.database),
+		a.logger,
+	)
+
+	suspensionSweep := jobs.NewPeriodic(
+		"lift_expired_suspensions",
+		a.config.Moderation.SuspensionSweepInterval,
+		func(ctx context.Context) error {
+			output, err := liftExpiredSuspensions.Execute(ctx, struct{}{})
+			if output != nil && output.Lifted > 0 {
+				a.logger.Infof("Lifted %d expired suspensions", output.Lifted)
+			}
+
+			return err
+		},
+		a.logger,
+	)
+
+	suspensionSweep.Start()
+	a.RegisterShutdowner(suspensionSweep)
+
+	anonymizeDeletedUsers := usecase.NewAnonymizeDeletedUsers(
+		a.database,
+		a.userCache,
+		repo.NewPostgresProfileRepository(a.database),
+		repo.NewPostgresOutboxRepository(a.database),
+		a.config.Deletion.GracePeriod,
+		a.logger,
+	)
+
+	deletionSweep := jobs.NewPeriodic(
+		"anonymize_deleted_users",
+		a.config.Deletion.SweepInterval,
+		func(ctx context.Context) error {
+			output, err := anonymizeDeletedUsers.Execute(ctx, struct{}{})
+			if output != nil && output.Anonymized > 0 {
+				a.logger.Infof("Anonymized %d deleted accounts", output.Anonymized)
+			}
+
+			return err
+		},
+		a.logger,
+	)
+
+	deletionSweep.Start()
+	a.RegisterShutdowner(deletionSweep)
+
+	expirePremium := usecase.NewExpirePremium(
+		a.database,
+		a.userCache,
+		repo.NewPostgresOutboxRepository(a.database),
+		a.logger,
+	)
+
+	premiumSweep := jobs.NewPeriodic(
+		"expire_premium",
+		a.config.Premium.SweepInterval,
+		func(ctx context.Context) error {
+			output, err := expirePremium.Execute(ctx, struct{}{})
+			if output != nil && output.Expired > 0 {
+				a.logger.Infof("Expired premium of %d users", output.Expired)
+			}
+
+			return err
+		},
+		a.logger,
+	)
+
+	premiumSweep.Start()
+	a.RegisterShutdowner(premiumSweep)
+
+	softReset, err := rating.NewSoftReset(a.config.Season.Compression, a.config.Season.ResetDeviation)
+	if err != nil {
+		return fmt.Errorf("invalid season reset config: %w", err)
+	}
+
+	resetSeasonRatings := usecase.NewResetSeasonRatings(
+		a.database,
+		repo.NewPostgresSeasonRepository(a.database, a.placement),
+		repo.NewPostgresRatingRepository(a.database, a.placement),
+		redisrepo.NewRedisLeaderboard(a.redisDatabase, leaderboardPrefix),
+		repo.NewPostgresOutboxRepository(a.database),
+		softReset,
+		a.config.Season.ResetBatchSize,
+	)
+
+	seasonResetSweep := jobs.NewPeriodic(
+		"reset_season_ratings",
+		a.config.Season.SweepInterval,
+		func(ctx context.Context) error {
+			output, err := resetSeasonRatings.Execute(ctx, struct{}{})
+			if output != nil {
+				for timeControl, reset := range output.Reset {
+					if reset > 0 {
+						a.logger.Infof("Reset %d %s ratings for the new season", reset, timeControl)
+					}
+				}
+			}
+
+			return err
+		},
+		a.logger,
+	)
+
+	seasonResetSweep.Start()
+	a.RegisterShutdowner(seasonResetSweep)
+
+	return nil
+}
+
+func (a *App) newEventPublisher() (outbox.EventPublisher, error) {
+	cfg := a.config.Events
+
+	switch cfg.Publisher {
+	case "", "log":
+		return events.NewLogPublisher(), nil
+	case "memory":
+		// Events published in memory are lost on restart, which is only acceptable outside production.
+		if env := a.config.App.Env; env != config.LocalEnv && env != config.DevelopEnv {
+			return nil, fmt.Errorf("memory event publisher is not allowed in %s environment", env)
+		}
+
+		return events.NewMemoryPublisher(), nil
+	case "redis":
+		return events.NewRedisStreamPublisher(a.redisDatabase, cfg.Stream, cfg.Partitions, cfg.MaxLen), nil
+	default:
+		return nil, fmt.Errorf("unknown event publisher: %s", cfg.Publisher)
+	}
+}
+
 func (a *App) SetupGRPCServer() {
 	a.gRPCServer = grpc.NewServer(
 		grpc.Creds(insecure.NewCredentials()),
@@ -112,7 +576,12 @@ func (a *App) SetupGRPCServer() {
 			grpcinterceptors.RequestTracking(a.requestTracker, a.logger),
 			interceptor.ErrorHandlingInterceptor(a.logger),
 		),
+		grpc.ChainStreamInterceptor(
+			grpcinterceptors.RequestTrackingStream(a.requestTracker, a.logger),
+			interceptor.ErrorHandlingStreamInterceptor(a.logger),
+		),
 	)
+	userproto.RegisterUserServiceServer(a.gRPCServer, a.userController)
 	reflection.Register(a.gRPCServer)
 }
 
@@ -172,9 +641,15 @@ func (a *App) Shutdown(ctx context.Context) error {
 		a.logger.Error("Timed out waiting for requests to complete", "error", err)
 	}
 
+	if a.userCache != nil {
+		stats := a.userCache.Stats()
+		a.logger.Infof("User cache: %d hits, %d misses", stats.Hits, stats.Misses)
+	}
+
 	a.logger.Info("Waiting for active requests to complete")
-	for _, shutdowner := range a.shutdowners {
-		if err := shutdowner.Shutdown(ctx); err != nil {
+	// Components are stopped in reverse registration order, so workers stop before the databases they use.
+	for i := len(a.shutdowners) - 1; i >= 0; i-- {
+		if err := a.shutdowners[i].Shutdown(ctx); err != nil {
 			a.logger.Error("Error shutting down component", "error", err)
 		}
 	}
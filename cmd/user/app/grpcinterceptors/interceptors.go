@@ -34,3 +34,38 @@ func RequestTracking(tracker *tracker.RequestTracker, logger *logrus.Logger) grp
 		return resp, err
 	}
 }
+
+func RequestTrackingStream(tracker *tracker.RequestTracker, logger *logrus.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		logger.Info("Tracking stream")
+
+		if tracker.IsShuttingDown() {
+			logger.Warnf("Rejected stream to %s: service is shutting down", info.FullMethod)
+			return status.Error(codes.Unavailable, "Service is shutting down")
+		}
+
+		requestID := uuid.New().String()
+		metadata := map[string]interface{}{
+			"method": info.FullMethod,
+		}
+
+		ctx := context.WithValue(ss.Context(), "request-id", requestID)
+
+		tracker.Begin(requestID, metadata)
+		err := handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+		tracker.End(requestID)
+
+		return err
+	}
+}
+
+// contextStream replaces the context of a stream, the way unary interceptors pass a new context to the handler.
+type contextStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
@@ -20,6 +20,25 @@ func main() {
 	ctx := context.Background()
 
 	a := app.New(cfg)
+
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err = a.Migrate(ctx, os.Args[2:]); err != nil {
+			fmt.Printf("Migration failed: %v\n", err)
+			os.Exit(1)
+		}
+
+		return
+	}
+
+	if len(os.Args) > 2 && os.Args[1] == "leaderboard" && os.Args[2] == "rebuild" {
+		if err = a.RebuildLeaderboards(ctx); err != nil {
+			fmt.Printf("Leaderboard rebuild failed: %v\n", err)
+			os.Exit(1)
+		}
+
+		return
+	}
+
 	if err = a.InitDeps(ctx); err != nil {
 		fmt.Printf("Failed to initialize dependencies: %v\n", err)
 		os.Exit(1)
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -19,7 +20,21 @@ const (
 type Config struct {
 	App      AppConfig      `mapstructure:"app"`
 	Database DatabaseConfig `mapstructure:"database"`
-	Redis    RedisConfig
+	Redis    RedisConfig    `mapstructure:"redis"`
+	Cache    CacheConfig    `mapstructure:"cache"`
+
+	Mail          MailConfig          `mapstructure:"mail"`
+	Verification  VerificationConfig  `mapstructure:"verification"`
+	PasswordReset PasswordResetConfig `mapstructure:"password_reset"`
+	Outbox        OutboxConfig        `mapstructure:"outbox"`
+	Events        EventsConfig        `mapstructure:"events"`
+	Moderation    ModerationConfig    `mapstructure:"moderation"`
+	Deletion      DeletionConfig      `mapstructure:"deletion"`
+	Premium       PremiumConfig       `mapstructure:"premium"`
+	Tag           TagConfig           `mapstructure:"tag"`
+	NamePolicy    NamePolicyConfig    `mapstructure:"name_policy"`
+	Season        SeasonConfig        `mapstructure:"season"`
+	Placement     PlacementConfig     `mapstructure:"placement"`
 }
 
 type AppConfig struct {
@@ -35,6 +50,8 @@ type DatabaseConfig struct {
 	User     string `mapstructure:"user"`
 	Password string `mapstructure:"password"`
 	SSLMode  string `mapstructure:"ssl_mode"`
+
+	AutoMigrate bool `mapstructure:"auto_migrate"`
 }
 
 type RedisConfig struct {
@@ -45,6 +62,97 @@ type RedisConfig struct {
 	DBNumber int    `mapstructure:"db_number"`
 }
 
+type CacheConfig struct {
+	UserTTL time.Duration `mapstructure:"user_ttl"`
+	// RatingChartTTL bounds how long the recent rating chart of a user is kept, entries are replaced on any rating
+	// change anyway.
+	RatingChartTTL time.Duration `mapstructure:"rating_chart_ttl"`
+}
+
+type MailConfig struct {
+	From     string `mapstructure:"from"`
+	FilePath string `mapstructure:"file_path"`
+}
+
+type VerificationConfig struct {
+	TokenTTL   time.Duration `mapstructure:"token_ttl"`
+	ConfirmURL string        `mapstructure:"confirm_url"`
+}
+
+type PasswordResetConfig struct {
+	TokenTTL   time.Duration `mapstructure:"token_ttl"`
+	ResetURL   string        `mapstructure:"reset_url"`
+	RateLimit  int           `mapstructure:"rate_limit"`
+	RateWindow time.Duration `mapstructure:"rate_window"`
+}
+
+type OutboxConfig struct {
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	BatchSize    int           `mapstructure:"batch_size"`
+	MaxBackoff   time.Duration `mapstructure:"max_backoff"`
+	Retention    time.Duration `mapstructure:"retention"`
+	CommitLag    time.Duration `mapstructure:"commit_lag"`
+}
+
+type EventsConfig struct {
+	// Publisher is one of "log", "memory" or "redis". "memory" is only allowed in the local and develop environments.
+	Publisher  string `mapstructure:"publisher"`
+	Stream     string `mapstructure:"stream"`
+	Partitions int    `mapstructure:"partitions"`
+	MaxLen     int64  `mapstructure:"max_len"`
+}
+
+type ModerationConfig struct {
+	// SuspensionSweepInterval is how often expired suspensions are lifted.
+	SuspensionSweepInterval time.Duration `mapstructure:"suspension_sweep_interval"`
+}
+
+type DeletionConfig struct {
+	// GracePeriod is how long a deleted account can be restored before it is anonymized.
+	GracePeriod time.Duration `mapstructure:"grace_period"`
+	// SweepInterval is how often accounts past their grace period are anonymized.
+	SweepInterval time.Duration `mapstructure:"sweep_interval"`
+}
+
+type PremiumConfig struct {
+	// SweepInterval is how often ended premium periods are expired.
+	SweepInterval time.Duration `mapstructure:"sweep_interval"`
+}
+
+type TagConfig struct {
+	// ChangeCooldown is how long a user has to wait between two tag changes.
+	ChangeCooldown time.Duration `mapstructure:"change_cooldown"`
+	// AliasTTL is how long a released tag keeps resolving to its previous owner.
+	AliasTTL time.Duration `mapstructure:"alias_ttl"`
+}
+
+type NamePolicyConfig struct {
+	// Reserved are the words public names and tags may not be built from, the built-in list when empty.
+	Reserved []string `mapstructure:"reserved"`
+	// Profanity extends the built-in profanity list.
+	Profanity []string `mapstructure:"profanity"`
+	// Allowed extends the built-in list of harmless words that contain a profane one.
+	Allowed []string `mapstructure:"allowed"`
+}
+
+type SeasonConfig struct {
+	// Compression is the share of the distance to the mean rating kept by the soft reset, 0 to 1.
+	Compression float64 `mapstructure:"compression"`
+	// ResetDeviation is the deviation ratings are raised to at the start of a season.
+	ResetDeviation float64 `mapstructure:"reset_deviation"`
+	// ResetBatchSize is the number of ratings reset per transaction.
+	ResetBatchSize int `mapstructure:"reset_batch_size"`
+	// SweepInterval is how often pending season resets are picked up.
+	SweepInterval time.Duration `mapstructure:"sweep_interval"`
+}
+
+type PlacementConfig struct {
+	// Games is the number of games a rating stays provisional for per time control, none for those left out.
+	Games map[string]int `mapstructure:"games"`
+	// Volatility is the least volatility provisional ratings are rated with.
+	Volatility float64 `mapstructure:"volatility"`
+}
+
 func Load(env, cfgPath string) (*Config, error) {
 	if cfgPath == "" {
 		return nil, errors.New("config file path is empty")
@@ -52,7 +160,12 @@ func Load(env, cfgPath string) (*Config, error) {
 
 	cfg := &Config{}
 
-	err := loadEnvCfg(env, cfgPath)
+	err := loadDefaultCfg(cfgPath)
+	if err != nil {
+		return nil, err
+	}
+
+	err = loadEnvCfg(env, cfgPath)
 	if err != nil {
 		return nil, err
 	}
@@ -69,6 +182,17 @@ func Load(env, cfgPath string) (*Config, error) {
 	return cfg, err
 }
 
+func loadDefaultCfg(cfgPath string) error {
+	viper.SetConfigName(defaultConfigName)
+	viper.AddConfigPath(cfgPath)
+
+	if err := viper.ReadInConfig(); err != nil {
+		return fmt.Errorf("failed to read base config: %w", err)
+	}
+
+	return nil
+}
+
 func loadEnvCfg(env string, cfgPath string) error {
 	isValidEnv := env == LocalEnv || env == DevelopEnv || env == ProdEnv
 	if !isValidEnv {
@@ -95,6 +219,7 @@ func loadEnvVariables() error {
 
 	envVars := []string{
 		"database.password",
+		"redis.password",
 	}
 
 	for _, envVar := range envVars {
@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+)
+
+type (
+	DeleteUserInputDTO struct {
+		UserID int64
+	}
+
+	DeleteUserOutputDTO struct {
+		Message string
+		// GracePeriod is the time left to restore the account.
+		GracePeriod time.Duration
+	}
+
+	RestoreUserInputDTO struct {
+		UserID int64
+	}
+
+	RestoreUserOutputDTO struct {
+		User *user.User
+	}
+
+	AnonymizeDeletedUsersOutputDTO struct {
+		Anonymized int
+		// Failed is the number of users that were skipped after an error, they are retried on the next run.
+		Failed int
+	}
+)
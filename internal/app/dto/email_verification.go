@@ -0,0 +1,21 @@
+package dto
+
+import "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+
+type (
+	SendEmailVerificationInputDTO struct {
+		UserID int64
+	}
+
+	SendEmailVerificationOutputDTO struct {
+		Message string
+	}
+
+	ConfirmEmailInputDTO struct {
+		Token string
+	}
+
+	ConfirmEmailOutputDTO struct {
+		User *user.User
+	}
+)
@@ -0,0 +1,16 @@
+package dto
+
+import "io"
+
+type (
+	// ExportUserDataInputDTO receives the JSON document in Output as it is assembled.
+	ExportUserDataInputDTO struct {
+		UserID int64
+		Output io.Writer
+	}
+
+	ExportUserDataOutputDTO struct {
+		Version        int
+		HistoryEntries int
+	}
+)
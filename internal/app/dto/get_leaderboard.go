@@ -0,0 +1,17 @@
+package dto
+
+import "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+
+type (
+	GetLeaderboardInputDTO struct {
+		TimeControl string
+		Limit       int
+		After       *user.LeaderboardCursor
+	}
+
+	GetLeaderboardOutputDTO struct {
+		Entries []*user.LeaderboardEntry
+		// Next is nil when the last page has been returned.
+		Next *user.LeaderboardCursor
+	}
+)
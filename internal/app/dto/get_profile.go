@@ -0,0 +1,13 @@
+package dto
+
+import "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+
+type (
+	GetProfileInputDTO struct {
+		UserID int64
+	}
+
+	GetProfileOutputDTO struct {
+		Profile *user.Profile
+	}
+)
@@ -0,0 +1,13 @@
+package dto
+
+import "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+
+type (
+	GetPublicProfilesInputDTO struct {
+		UserIDs []int64
+	}
+
+	GetPublicProfilesOutputDTO struct {
+		Profiles []*user.Profile
+	}
+)
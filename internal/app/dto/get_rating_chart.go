@@ -0,0 +1,24 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+)
+
+type (
+	// GetRatingChartInputDTO covers the last 90 days when neither From nor To is set.
+	GetRatingChartInputDTO struct {
+		UserID      int64
+		TimeControl string
+		// Interval defaults to days when empty.
+		Interval string
+		From     *time.Time
+		To       *time.Time
+	}
+
+	GetRatingChartOutputDTO struct {
+		Interval string
+		Buckets  []*user.RatingBucket
+	}
+)
@@ -0,0 +1,17 @@
+package dto
+
+import "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+
+type (
+	GetRatingsInputDTO struct {
+		UserID int64
+		// TimeControl restricts the output to a single time control when not empty.
+		TimeControl  string
+		HistoryLimit int
+	}
+
+	GetRatingsOutputDTO struct {
+		Ratings []*user.Rating
+		History []*user.RatingHistory
+	}
+)
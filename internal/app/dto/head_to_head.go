@@ -0,0 +1,28 @@
+package dto
+
+import "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+
+type (
+	GetHeadToHeadInputDTO struct {
+		UserID     int64
+		OpponentID int64
+	}
+
+	GetHeadToHeadOutputDTO struct {
+		// Records holds one record per time control the users played in, from the side of the user.
+		Records []*user.HeadToHead
+		// Total sums the records, its time control is empty.
+		Total *user.HeadToHead
+	}
+
+	GetTopOpponentsInputDTO struct {
+		UserID int64
+		// TimeControl limits the games counted to one time control, every one is counted when empty.
+		TimeControl string
+		Limit       int
+	}
+
+	GetTopOpponentsOutputDTO struct {
+		Opponents []*user.HeadToHead
+	}
+)
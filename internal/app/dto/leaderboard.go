@@ -0,0 +1,29 @@
+package dto
+
+import "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+
+type (
+	GetLeaderboardAroundUserInputDTO struct {
+		UserID      int64
+		TimeControl string
+		Radius      int
+	}
+
+	GetLeaderboardAroundUserOutputDTO struct {
+		Entries []*user.LeaderboardEntry
+	}
+
+	GetUserRankInputDTO struct {
+		UserID      int64
+		TimeControl string
+	}
+
+	GetUserRankOutputDTO struct {
+		Rank int
+	}
+
+	RebuildLeaderboardsOutputDTO struct {
+		// Rebuilt holds the number of ratings written per time control.
+		Rebuilt map[string]int
+	}
+)
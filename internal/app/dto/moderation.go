@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+)
+
+type (
+	// SuspendUserInputDTO ends the suspension at Until when set, otherwise after Duration.
+	SuspendUserInputDTO struct {
+		UserID   int64
+		ActorID  int64
+		Reason   string
+		Until    *time.Time
+		Duration time.Duration
+	}
+
+	BanUserInputDTO struct {
+		UserID  int64
+		ActorID int64
+		Reason  string
+	}
+
+	ReinstateUserInputDTO struct {
+		UserID  int64
+		ActorID int64
+		Reason  string
+	}
+
+	ModerateUserOutputDTO struct {
+		User *user.User
+	}
+
+	GetModerationLogInputDTO struct {
+		UserID int64
+		Limit  int
+	}
+
+	GetModerationLogOutputDTO struct {
+		Records []*user.ModerationRecord
+	}
+
+	LiftExpiredSuspensionsOutputDTO struct {
+		Lifted int
+		// Failed is the number of users that were skipped after an error, they are retried on the next run.
+		Failed int
+	}
+)
@@ -0,0 +1,30 @@
+package dto
+
+type (
+	RequestPasswordResetInputDTO struct {
+		Email string
+	}
+
+	RequestPasswordResetOutputDTO struct {
+		Message string
+	}
+
+	ConfirmPasswordResetInputDTO struct {
+		Token       string
+		NewPassword string
+	}
+
+	ConfirmPasswordResetOutputDTO struct {
+		Message string
+	}
+
+	ChangePasswordInputDTO struct {
+		UserID      int64
+		OldPassword string
+		NewPassword string
+	}
+
+	ChangePasswordOutputDTO struct {
+		Message string
+	}
+)
@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+)
+
+type (
+	GrantPremiumInputDTO struct {
+		UserID   int64
+		Duration time.Duration
+	}
+
+	RevokePremiumInputDTO struct {
+		UserID int64
+	}
+
+	PremiumOutputDTO struct {
+		User *user.User
+	}
+
+	// GetPremiumStatusInputDTO checks the current time when At is not set. At must not be in the past.
+	GetPremiumStatusInputDTO struct {
+		UserID int64
+		At     *time.Time
+	}
+
+	GetPremiumStatusOutputDTO struct {
+		IsPremium    bool
+		PremiumUntil *time.Time
+	}
+
+	ExpirePremiumOutputDTO struct {
+		Expired int
+		// Failed is the number of users that were skipped after an error, they are retried on the next run.
+		Failed int
+	}
+)
@@ -0,0 +1,41 @@
+package dto
+
+import "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+
+type (
+	// AdjustRatingInputDTO changes the rating by Delta or sets it to Value, exactly one of them must be set.
+	AdjustRatingInputDTO struct {
+		UserID      int64
+		TimeControl string
+		Delta       *int
+		Value       *int
+		ActorID     int64
+		Note        string
+	}
+
+	PenalizeRatingInputDTO struct {
+		UserID      int64
+		TimeControl string
+		Points      int
+		ActorID     int64
+		Note        string
+	}
+
+	AdjustRatingOutputDTO struct {
+		Rating  *user.Rating
+		History *user.RatingHistory
+	}
+
+	RefundOpponentsInputDTO struct {
+		CheaterID int64
+		GameIDs   []string
+		ActorID   int64
+		Note      string
+	}
+
+	RefundOpponentsOutputDTO struct {
+		Refunds []*user.RatingHistory
+		// SkippedGameIDs were not played by the cheater, not lost by the opponent or are already refunded.
+		SkippedGameIDs []string
+	}
+)
@@ -0,0 +1,25 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+)
+
+type (
+	RecordGameResultInputDTO struct {
+		GameID      string
+		WhiteUserID int64
+		BlackUserID int64
+		TimeControl string
+		Outcome     string
+		FinishedAt  *time.Time
+	}
+
+	RecordGameResultOutputDTO struct {
+		White *user.Rating
+		Black *user.Rating
+		// AlreadyRecorded is set when the game had been reported before and ratings were left untouched.
+		AlreadyRecorded bool
+	}
+)
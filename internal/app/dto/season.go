@@ -0,0 +1,32 @@
+package dto
+
+import "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+
+type (
+	StartSeasonInputDTO struct {
+		Name string
+	}
+
+	StartSeasonOutputDTO struct {
+		Season *user.Season
+		// Ended is the season that was in progress, nil when this is the first one.
+		Ended *user.Season
+	}
+
+	GetSeasonStandingsInputDTO struct {
+		SeasonID    int64
+		TimeControl string
+		Limit       int
+		After       *user.LeaderboardCursor
+	}
+
+	GetSeasonStandingsOutputDTO struct {
+		Standings []*user.SeasonStanding
+		Next      *user.LeaderboardCursor
+	}
+
+	ResetSeasonRatingsOutputDTO struct {
+		// Reset holds the number of ratings reset per time control.
+		Reset map[string]int
+	}
+)
@@ -0,0 +1,24 @@
+package dto
+
+import "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+
+type (
+	ChangeTagInputDTO struct {
+		UserID int64
+		Tag    string
+	}
+
+	ChangeTagOutputDTO struct {
+		User *user.User
+	}
+
+	GetUserByTagInputDTO struct {
+		Tag string
+	}
+
+	GetUserByTagOutputDTO struct {
+		User *user.User
+		// RedirectedFrom is the requested tag when it is a tag the user has changed away from.
+		RedirectedFrom string
+	}
+)
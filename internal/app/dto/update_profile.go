@@ -0,0 +1,30 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+)
+
+type (
+	// UpdateProfileInputDTO changes only the fields that are set. An empty string clears an optional text field.
+	UpdateProfileInputDTO struct {
+		UserID            int64
+		PublicName        *string
+		Bio               *string
+		CountryCode       *string
+		City              *string
+		BirthDate         *time.Time
+		AvatarURL         *string
+		CoverImageURL     *string
+		IsPublic          *bool
+		ShowCountry       *bool
+		WebsiteURL        *string
+		TwitchUsername    *string
+		YoutubeChannelURL *string
+	}
+
+	UpdateProfileOutputDTO struct {
+		Profile *user.Profile
+	}
+)
@@ -0,0 +1,24 @@
+package dto
+
+import "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+
+type (
+	GetUserInputDTO struct {
+		UserID int64
+	}
+
+	GetUserOutputDTO struct {
+		User *user.User
+	}
+
+	// UpdateUserInputDTO changes only the fields that are set.
+	UpdateUserInputDTO struct {
+		UserID   int64
+		Email    *string
+		Language *string
+	}
+
+	UpdateUserOutputDTO struct {
+		User *user.User
+	}
+)
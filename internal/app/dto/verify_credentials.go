@@ -0,0 +1,15 @@
+package dto
+
+import "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+
+type (
+	VerifyCredentialsInputDTO struct {
+		Email    string
+		Tag      string
+		Password string
+	}
+
+	VerifyCredentialsOutputDTO struct {
+		User *user.User
+	}
+)
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
 )
 
 type ErrorType int
@@ -17,6 +19,7 @@ const (
 	Forbidden
 	Canceled
 	DeadlineExceeded
+	ResourceExhausted
 )
 
 func (c ErrorType) String() string {
@@ -37,6 +40,8 @@ func (c ErrorType) String() string {
 		return "DEADLINE_EXCEEDED_ERROR"
 	case Canceled:
 		return "CANCELED_ERROR"
+	case ResourceExhausted:
+		return "RESOURCE_EXHAUSTED_ERROR"
 	default:
 		return "UNKNOWN_ERROR"
 	}
@@ -120,6 +125,10 @@ func NewCanceledError(msg string) *AppError {
 	return NewAppError(Canceled, msg, nil, nil)
 }
 
+func NewResourceExhaustedError(msg string) *AppError {
+	return NewAppError(ResourceExhausted, msg, nil, nil)
+}
+
 func FromDomainError(err error) *AppError {
 	var e *AppError
 
@@ -130,6 +139,60 @@ func FromDomainError(err error) *AppError {
 		return NewDeadlineExceededError("Operation time out.").WithCause(err)
 	case errors.As(err, &e):
 		return e
+	case errors.Is(err, domainerrors.ErrUserNotFound):
+		return NewNotFoundError("User not found.").WithCause(err)
+	case errors.Is(err, domainerrors.ErrEmailUnavailable):
+		return NewConflictError("Email is already taken.").WithMetadata("field", "email").WithCause(err)
+	case errors.Is(err, domainerrors.ErrUsernameUnavailable):
+		return NewConflictError("Name is already taken.").WithCause(err)
+	case errors.Is(err, domainerrors.ErrUserAlreadyExists):
+		return NewConflictError("User already exists.").WithCause(err)
+	case errors.Is(err, domainerrors.ErrEmailAlreadyVerified):
+		return NewConflictError("Email is already verified.").WithCause(err)
+	case errors.Is(err, domainerrors.ErrInvalidToken):
+		return NewInvalidArgumentError("Token is invalid or expired.", nil).WithMetadata("field", "token").WithCause(err)
+	case errors.Is(err, domainerrors.ErrRatingNotFound):
+		return NewNotFoundError("Rating not found.").WithCause(err)
+	case errors.Is(err, domainerrors.ErrInvalidRatingChange):
+		return NewInvalidArgumentError("Invalid rating change.", nil).WithCause(err)
+	case errors.Is(err, domainerrors.ErrGameAlreadyRecorded):
+		return NewConflictError("Game is already recorded.").WithCause(err)
+	case errors.Is(err, domainerrors.ErrInvalidStatusChange):
+		return NewConflictError("User status cannot be changed.").WithCause(err)
+	case errors.Is(err, domainerrors.ErrReasonRequired):
+		return NewInvalidArgumentError("Reason is required.", nil).WithMetadata("field", "reason").WithCause(err)
+	case errors.Is(err, domainerrors.ErrInvalidSuspension):
+		return NewInvalidArgumentError("Suspension must end in the future.", nil).WithMetadata("field", "until").WithCause(err)
+	case errors.Is(err, domainerrors.ErrRestoreUnavailable):
+		return NewConflictError("Account can no longer be restored.").WithCause(err)
+	case errors.Is(err, domainerrors.ErrInvalidPremiumPeriod):
+		return NewInvalidArgumentError("Premium period must be positive.", nil).WithMetadata("field", "duration").WithCause(err)
+	case errors.Is(err, domainerrors.ErrNotPremium):
+		return NewConflictError("User is not premium.").WithCause(err)
+	case errors.Is(err, domainerrors.ErrTagUnchanged):
+		return NewInvalidArgumentError("Tag is unchanged.", nil).WithMetadata("field", "tag").WithCause(err)
+	case errors.Is(err, domainerrors.ErrInvalidTag):
+		return NewInvalidArgumentError("Tag is invalid.", nil).WithMetadata("field", "tag").WithCause(err)
+	case errors.Is(err, domainerrors.ErrNameReserved):
+		return NewInvalidArgumentError("Name is reserved.", nil).WithCause(err)
+	case errors.Is(err, domainerrors.ErrNameProfane):
+		return NewInvalidArgumentError("Name contains inappropriate language.", nil).WithCause(err)
+	case errors.Is(err, domainerrors.ErrSeasonNotFound):
+		return NewNotFoundError("Season not found.").WithCause(err)
+	case errors.Is(err, domainerrors.ErrSeasonEnded):
+		return NewConflictError("Season has already ended.").WithCause(err)
+	case errors.Is(err, domainerrors.ErrSeasonResetPending):
+		return NewConflictError("Reset of the previous season is still in progress.").WithCause(err)
+	case errors.Is(err, domainerrors.ErrInvalidCredentials):
+		return NewUnauthenticatedError("Invalid credentials.").WithCause(err)
+	case errors.Is(err, domainerrors.ErrUserSuspended):
+		return NewForbiddenError("User is suspended.").WithCause(err)
+	case errors.Is(err, domainerrors.ErrUserBanned):
+		return NewForbiddenError("User is banned.").WithCause(err)
+	case errors.Is(err, domainerrors.ErrUserDeleted):
+		return NewConflictError("User is deleted.").WithCause(err)
+	case errors.Is(err, domainerrors.ErrUserNotVerified):
+		return NewForbiddenError("User is not verified.").WithCause(err)
 	default:
 		return NewInternalError("Unexpected server error.").WithCause(err)
 	}
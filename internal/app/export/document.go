@@ -0,0 +1,185 @@
+package export
+
+import (
+	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+)
+
+// FormatVersion is bumped whenever a field of the document is renamed or removed, new fields keep the version.
+const FormatVersion = 1
+
+// Redacted replaces values that are stored for the user but must never leave the service.
+const Redacted = "[REDACTED]"
+
+// The document is a JSON object with these top-level fields, in this order: version, generated_at, user,
+// profile, login, ratings, moderation and rating_history. rating_history comes last so it can be streamed.
+
+type User struct {
+	ID              int64      `json:"id"`
+	Tag             string     `json:"tag"`
+	Email           string     `json:"email"`
+	Password        string     `json:"password"`
+	Status          string     `json:"status"`
+	IsVerified      bool       `json:"is_verified"`
+	IsPremium       bool       `json:"is_premium"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at"`
+	PremiumUntil    *time.Time `json:"premium_until"`
+	SuspendedUntil  *time.Time `json:"suspended_until"`
+	DeletedAt       *time.Time `json:"deleted_at"`
+	Language        string     `json:"language"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+func NewUser(u *user.User) *User {
+	return &User{
+		ID:              u.ID(),
+		Tag:             u.Tag().Value(),
+		Email:           u.Email().Value(),
+		Password:        Redacted,
+		Status:          string(u.Status()),
+		IsVerified:      u.IsVerified(),
+		IsPremium:       u.IsPremium(),
+		EmailVerifiedAt: u.EmailVerifiedAt(),
+		PremiumUntil:    u.PremiumUntil(),
+		SuspendedUntil:  u.SuspendedUntil(),
+		DeletedAt:       u.DeletedAt(),
+		Language:        u.Language(),
+		UpdatedAt:       u.UpdatedAt(),
+		CreatedAt:       u.CreatedAt(),
+	}
+}
+
+// Login holds what the service records about the user's sign-ins.
+type Login struct {
+	LastLoginAt  *time.Time `json:"last_login_at"`
+	LastActiveAt time.Time  `json:"last_active_at"`
+}
+
+func NewLogin(u *user.User) *Login {
+	return &Login{
+		LastLoginAt:  u.LastLoginAt(),
+		LastActiveAt: u.LastActiveAt(),
+	}
+}
+
+type Profile struct {
+	PublicName        string     `json:"public_name"`
+	Bio               *string    `json:"bio"`
+	CountryCode       *string    `json:"country_code"`
+	City              *string    `json:"city"`
+	BirthDate         *time.Time `json:"birth_date"`
+	AvatarURL         *string    `json:"avatar_url"`
+	CoverImageURL     *string    `json:"cover_image_url"`
+	IsPublic          bool       `json:"is_public"`
+	ShowCountry       bool       `json:"show_country"`
+	WebsiteURL        *string    `json:"website_url"`
+	TwitchUsername    *string    `json:"twitch_username"`
+	YoutubeChannelURL *string    `json:"youtube_channel_url"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+	CreatedAt         time.Time  `json:"created_at"`
+}
+
+func NewProfile(p *user.Profile) *Profile {
+	profile := &Profile{
+		Bio:               p.Bio,
+		CountryCode:       p.CountryCode,
+		City:              p.City,
+		BirthDate:         p.BirthDate,
+		AvatarURL:         p.AvatarURL,
+		CoverImageURL:     p.CoverImageURL,
+		IsPublic:          p.IsPublic,
+		ShowCountry:       p.ShowCountry,
+		WebsiteURL:        p.WebsiteURL,
+		TwitchUsername:    p.TwitchUsername,
+		YoutubeChannelURL: p.YoutubeChannelURL,
+		UpdatedAt:         p.UpdatedAt,
+		CreatedAt:         p.CreatedAt,
+	}
+
+	if p.PublicName != nil {
+		profile.PublicName = p.PublicName.Value()
+	}
+
+	return profile
+}
+
+type Rating struct {
+	TimeControl  string     `json:"time_control"`
+	Rating       int        `json:"rating"`
+	Deviation    float64    `json:"deviation"`
+	Volatility   float64    `json:"volatility"`
+	PeakRating   int        `json:"peak_rating"`
+	LowestRating int        `json:"lowest_rating"`
+	GamesPlayed  int        `json:"games_played"`
+	Wins         int        `json:"wins"`
+	Losses       int        `json:"losses"`
+	Draws        int        `json:"draws"`
+	LastGameAt   *time.Time `json:"last_game_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+func NewRating(r *user.Rating) *Rating {
+	return &Rating{
+		TimeControl:  string(r.TimeControl),
+		Rating:       r.Rating,
+		Deviation:    r.Deviation,
+		Volatility:   r.Volatility,
+		PeakRating:   r.PeakRating,
+		LowestRating: r.LowestRating,
+		GamesPlayed:  r.GamesPlayed,
+		Wins:         r.Wins,
+		Losses:       r.Losses,
+		Draws:        r.Draws,
+		LastGameAt:   r.LastGameAt,
+		UpdatedAt:    r.UpdatedAt,
+		CreatedAt:    r.CreatedAt,
+	}
+}
+
+type RatingHistory struct {
+	ID           string    `json:"id"`
+	TimeControl  string    `json:"time_control"`
+	OldRating    int       `json:"old_rating"`
+	NewRating    int       `json:"new_rating"`
+	GameID       *string   `json:"game_id"`
+	ChangeReason string    `json:"change_reason"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func NewRatingHistory(h *user.RatingHistory) *RatingHistory {
+	history := &RatingHistory{
+		ID:           h.Id.String(),
+		TimeControl:  string(h.TimeControl),
+		OldRating:    h.OldRating,
+		NewRating:    h.NewRating,
+		ChangeReason: string(h.ChangeReason),
+		CreatedAt:    h.CreatedAt,
+	}
+
+	if h.GameID != nil {
+		gameID := h.GameID.String()
+		history.GameID = &gameID
+	}
+
+	return history
+}
+
+// ModerationRecord leaves out the moderator, who is not the subject of the export.
+type ModerationRecord struct {
+	Action         string     `json:"action"`
+	Reason         string     `json:"reason"`
+	SuspendedUntil *time.Time `json:"suspended_until"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+func NewModerationRecord(r *user.ModerationRecord) *ModerationRecord {
+	return &ModerationRecord{
+		Action:         string(r.Action),
+		Reason:         r.Reason,
+		SuspendedUntil: r.SuspendedUntil,
+		CreatedAt:      r.CreatedAt,
+	}
+}
@@ -0,0 +1,125 @@
+package export
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+var errArrayOpen = errors.New("export: array is still open")
+
+// Writer writes a JSON object field by field, so arrays of any length can be written without holding them in
+// memory. The first error is kept and returned by every later call.
+type Writer struct {
+	out     io.Writer
+	err     error
+	started bool
+	inArray bool
+	first   bool
+}
+
+func NewWriter(out io.Writer) *Writer {
+	return &Writer{out: out}
+}
+
+func (w *Writer) Field(name string, value any) error {
+	if w.inArray {
+		return w.fail(errArrayOpen)
+	}
+
+	w.key(name)
+	w.value(value)
+
+	return w.err
+}
+
+// BeginArray opens an array field, its elements are written with Element until EndArray.
+func (w *Writer) BeginArray(name string) error {
+	if w.inArray {
+		return w.fail(errArrayOpen)
+	}
+
+	w.key(name)
+	w.write("[")
+
+	w.inArray = true
+	w.first = true
+
+	return w.err
+}
+
+func (w *Writer) Element(value any) error {
+	if !w.first {
+		w.write(",")
+	}
+
+	w.first = false
+	w.value(value)
+
+	return w.err
+}
+
+func (w *Writer) EndArray() error {
+	w.write("]")
+	w.inArray = false
+
+	return w.err
+}
+
+// Close ends the object.
+func (w *Writer) Close() error {
+	if w.inArray {
+		return w.fail(errArrayOpen)
+	}
+
+	if !w.started {
+		w.write("{")
+	}
+
+	w.write("}")
+
+	return w.err
+}
+
+func (w *Writer) key(name string) {
+	if w.started {
+		w.write(",")
+	} else {
+		w.write("{")
+		w.started = true
+	}
+
+	w.value(name)
+	w.write(":")
+}
+
+func (w *Writer) value(value any) {
+	if w.err != nil {
+		return
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		w.err = err
+
+		return
+	}
+
+	w.write(string(data))
+}
+
+func (w *Writer) write(s string) {
+	if w.err != nil {
+		return
+	}
+
+	_, w.err = io.WriteString(w.out, s)
+}
+
+func (w *Writer) fail(err error) error {
+	if w.err == nil {
+		w.err = err
+	}
+
+	return w.err
+}
@@ -0,0 +1,62 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("closed")
+}
+
+func TestWriter(t *testing.T) {
+	t.Run("should write fields and arrays as one object", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		w := NewWriter(&buf)
+		require.NoError(t, w.Field("version", 1))
+		require.NoError(t, w.BeginArray("empty"))
+		require.NoError(t, w.EndArray())
+		require.NoError(t, w.BeginArray("items"))
+		require.NoError(t, w.Element(map[string]int{"a": 1}))
+		require.NoError(t, w.Element(map[string]int{"a": 2}))
+		require.NoError(t, w.EndArray())
+		require.NoError(t, w.Close())
+
+		assert.JSONEq(t, `{"version":1,"empty":[],"items":[{"a":1},{"a":2}]}`, buf.String())
+		assert.True(t, json.Valid(buf.Bytes()))
+	})
+
+	t.Run("should write an empty object", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		require.NoError(t, NewWriter(&buf).Close())
+		assert.Equal(t, "{}", buf.String())
+	})
+
+	t.Run("should reject fields while an array is open", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		w := NewWriter(&buf)
+		require.NoError(t, w.BeginArray("items"))
+
+		assert.ErrorIs(t, w.Field("version", 1), errArrayOpen)
+		assert.ErrorIs(t, w.Close(), errArrayOpen)
+	})
+
+	t.Run("should keep the first write error", func(t *testing.T) {
+		w := NewWriter(failingWriter{})
+
+		err := w.Field("version", 1)
+		require.EqualError(t, err, "closed")
+
+		assert.EqualError(t, w.Close(), "closed")
+	})
+}
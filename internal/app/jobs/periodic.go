@@ -0,0 +1,79 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Periodic runs a job in the background at a fixed interval. A run that fails is logged and retried on the next
+// tick, so jobs must be safe to repeat and to run on several replicas at once.
+type Periodic struct {
+	name     string
+	interval time.Duration
+	run      func(ctx context.Context) error
+	logger   logrus.FieldLogger
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+func NewPeriodic(name string, interval time.Duration, run func(ctx context.Context) error, logger logrus.FieldLogger) *Periodic {
+	return &Periodic{
+		name:     name,
+		interval: interval,
+		run:      run,
+		logger:   logger.WithField("job", name),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Start runs the job once per interval until Shutdown is called.
+func (p *Periodic) Start() {
+	go func() {
+		defer close(p.done)
+
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-p.stop:
+				return
+			case <-ticker.C:
+				p.tick()
+			}
+		}
+	}()
+}
+
+// Shutdown stops the ticker and waits for a run in progress, which is cancelled once ctx is done.
+func (p *Periodic) Shutdown(ctx context.Context) error {
+	close(p.stop)
+
+	select {
+	case <-p.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *Periodic) tick() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		select {
+		case <-p.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	if err := p.run(ctx); err != nil {
+		p.logger.WithError(err).Error("Job failed")
+	}
+}
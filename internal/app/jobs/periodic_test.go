@@ -0,0 +1,57 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeriodic(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	t.Run("should keep running after a failed run", func(t *testing.T) {
+		var runs atomic.Int32
+
+		job := NewPeriodic("test", time.Millisecond, func(context.Context) error {
+			runs.Add(1)
+
+			return errors.New("boom")
+		}, logger)
+
+		job.Start()
+
+		assert.Eventually(t, func() bool { return runs.Load() >= 3 }, time.Second, time.Millisecond)
+		require.NoError(t, job.Shutdown(context.Background()))
+	})
+
+	t.Run("should cancel the run in progress on shutdown", func(t *testing.T) {
+		started := make(chan struct{})
+
+		job := NewPeriodic("test", time.Millisecond, func(ctx context.Context) error {
+			select {
+			case started <- struct{}{}:
+			default:
+			}
+
+			<-ctx.Done()
+
+			return ctx.Err()
+		}, logger)
+
+		job.Start()
+		<-started
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		require.NoError(t, job.Shutdown(ctx))
+	})
+}
@@ -0,0 +1,37 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Message is an event recorded in the outbox together with its delivery state.
+type Message struct {
+	ID            int64
+	EventID       uuid.UUID
+	EventType     string
+	AggregateID   int64
+	Payload       []byte
+	Attempts      int
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+}
+
+// Store gives the relay access to recorded messages. Every method must run inside a transaction.
+type Store interface {
+	// Lock takes the relay lock for the current transaction and reports false when another relay holds it.
+	Lock(ctx context.Context) (bool, error)
+	// FetchPending returns undelivered messages in id order, stopping before the first one recorded less than
+	// commitLag ago.
+	FetchPending(ctx context.Context, limit int, commitLag time.Duration) ([]*Message, error)
+	MarkDelivered(ctx context.Context, ids []int64) error
+	MarkFailed(ctx context.Context, id int64, cause string, retryAt time.Time) error
+	PurgeDelivered(ctx context.Context, before time.Time) (int64, error)
+}
+
+// EventPublisher hands recorded messages to the message broker other services consume from.
+type EventPublisher interface {
+	Publish(ctx context.Context, msg *Message) error
+}
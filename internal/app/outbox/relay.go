@@ -0,0 +1,178 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/usecase"
+)
+
+type RelayConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+	MaxBackoff   time.Duration
+	// Retention is how long delivered messages are kept before they are purged.
+	Retention time.Duration
+	// CommitLag is how long a message is held back after it is recorded, giving the transactions that took
+	// lower ids time to commit.
+	CommitLag time.Duration
+}
+
+// Relay publishes outbox messages in id order. Ids are taken when a message is recorded, not when its
+// transaction commits, so a message is only published once it is older than CommitLag: the order matches the
+// commit order as long as every transaction commits within CommitLag of recording its messages. A message
+// whose transaction takes longer can be published after ones recorded later, consumers that care must check
+// the state they are given rather than rely on the order. Only one relay works at a time across replicas,
+// and a message that cannot be published holds back the ones after it until a retry succeeds. Delivery is at
+// least once: consumers deduplicate by event id.
+type Relay struct {
+	transactor usecase.Transactor
+	store      Store
+	publisher  EventPublisher
+	logger     logrus.FieldLogger
+	config     RelayConfig
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+func NewRelay(
+	transactor usecase.Transactor,
+	store Store,
+	publisher EventPublisher,
+	logger logrus.FieldLogger,
+	config RelayConfig,
+) *Relay {
+	return &Relay{
+		transactor: transactor,
+		store:      store,
+		publisher:  publisher,
+		logger:     logger,
+		config:     config,
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+}
+
+// Start polls the outbox in the background until Shutdown is called.
+func (r *Relay) Start() {
+	go func() {
+		defer close(r.done)
+
+		ticker := time.NewTicker(r.config.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-r.stop:
+				return
+			case <-ticker.C:
+				r.tick()
+			}
+		}
+	}()
+}
+
+func (r *Relay) Shutdown(ctx context.Context) error {
+	close(r.stop)
+
+	select {
+	case <-r.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (r *Relay) tick() {
+	ctx, cancel := context.WithTimeout(context.Background(), r.config.PollInterval*10)
+	defer cancel()
+
+	// Keep going while full batches are published so a backlog drains faster than one batch per interval.
+	for {
+		published, err := r.RelayBatch(ctx)
+		if err != nil {
+			r.logger.WithError(err).Error("Failed to relay outbox messages")
+
+			return
+		}
+
+		if published < r.config.BatchSize {
+			break
+		}
+	}
+
+	if r.config.Retention <= 0 {
+		return
+	}
+
+	err := r.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		_, err := r.store.PurgeDelivered(ctx, time.Now().Add(-r.config.Retention))
+
+		return err
+	})
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to purge delivered outbox messages")
+	}
+}
+
+// RelayBatch publishes up to one batch of pending messages and returns how many were delivered.
+func (r *Relay) RelayBatch(ctx context.Context) (int, error) {
+	var published int
+
+	err := r.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		locked, err := r.store.Lock(ctx)
+		if err != nil || !locked {
+			return err
+		}
+
+		messages, err := r.store.FetchPending(ctx, r.config.BatchSize, r.config.CommitLag)
+		if err != nil {
+			return err
+		}
+
+		delivered := make([]int64, 0, len(messages))
+		now := time.Now()
+
+		for _, msg := range messages {
+			if msg.NextAttemptAt.After(now) {
+				break
+			}
+
+			if err = r.publisher.Publish(ctx, msg); err != nil {
+				r.logger.WithError(err).WithField("event_id", msg.EventID).Warn("Failed to publish outbox message")
+
+				if err = r.store.MarkFailed(ctx, msg.ID, err.Error(), now.Add(r.backoff(msg.Attempts))); err != nil {
+					return err
+				}
+
+				break
+			}
+
+			delivered = append(delivered, msg.ID)
+		}
+
+		published = len(delivered)
+
+		return r.store.MarkDelivered(ctx, delivered)
+	})
+
+	return published, err
+}
+
+// backoff doubles the poll interval with every failed attempt, up to MaxBackoff.
+func (r *Relay) backoff(attempts int) time.Duration {
+	delay := r.config.PollInterval
+
+	for range attempts {
+		delay *= 2
+
+		if delay >= r.config.MaxBackoff {
+			return r.config.MaxBackoff
+		}
+	}
+
+	return delay
+}
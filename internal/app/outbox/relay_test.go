@@ -0,0 +1,133 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTransactor struct{}
+
+func (fakeTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type fakeStore struct {
+	locked    bool
+	pending   []*Message
+	delivered []int64
+	failed    map[int64]time.Time
+}
+
+func (s *fakeStore) Lock(_ context.Context) (bool, error) { return s.locked, nil }
+
+func (s *fakeStore) FetchPending(_ context.Context, limit int, _ time.Duration) ([]*Message, error) {
+	return s.pending[:min(limit, len(s.pending))], nil
+}
+
+func (s *fakeStore) MarkDelivered(_ context.Context, ids []int64) error {
+	s.delivered = append(s.delivered, ids...)
+
+	return nil
+}
+
+func (s *fakeStore) MarkFailed(_ context.Context, id int64, _ string, retryAt time.Time) error {
+	s.failed[id] = retryAt
+
+	return nil
+}
+
+func (s *fakeStore) PurgeDelivered(_ context.Context, _ time.Time) (int64, error) { return 0, nil }
+
+type fakePublisher struct {
+	failOn    int64
+	published []int64
+}
+
+func (p *fakePublisher) Publish(_ context.Context, msg *Message) error {
+	if msg.ID == p.failOn {
+		return errors.New("broker unavailable")
+	}
+
+	p.published = append(p.published, msg.ID)
+
+	return nil
+}
+
+func TestRelay_RelayBatch(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	config := RelayConfig{PollInterval: time.Second, BatchSize: 10, MaxBackoff: 5 * time.Second}
+
+	messages := func(ids ...int64) []*Message {
+		result := make([]*Message, 0, len(ids))
+		for _, id := range ids {
+			result = append(result, &Message{ID: id})
+		}
+
+		return result
+	}
+
+	t.Run("should publish pending messages in order", func(t *testing.T) {
+		store := &fakeStore{locked: true, pending: messages(1, 2, 3), failed: map[int64]time.Time{}}
+		publisher := &fakePublisher{}
+
+		published, err := NewRelay(fakeTransactor{}, store, publisher, logger, config).RelayBatch(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, 3, published)
+		assert.Equal(t, []int64{1, 2, 3}, publisher.published)
+		assert.Equal(t, []int64{1, 2, 3}, store.delivered)
+	})
+
+	t.Run("should hold back messages after a failed one", func(t *testing.T) {
+		store := &fakeStore{locked: true, pending: messages(1, 2, 3), failed: map[int64]time.Time{}}
+		publisher := &fakePublisher{failOn: 2}
+
+		published, err := NewRelay(fakeTransactor{}, store, publisher, logger, config).RelayBatch(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, published)
+		assert.Equal(t, []int64{1}, store.delivered)
+		assert.Contains(t, store.failed, int64(2))
+	})
+
+	t.Run("should do nothing when another relay holds the lock", func(t *testing.T) {
+		store := &fakeStore{pending: messages(1), failed: map[int64]time.Time{}}
+		publisher := &fakePublisher{}
+
+		published, err := NewRelay(fakeTransactor{}, store, publisher, logger, config).RelayBatch(context.Background())
+		require.NoError(t, err)
+
+		assert.Zero(t, published)
+		assert.Empty(t, publisher.published)
+	})
+
+	t.Run("should not publish before the retry time", func(t *testing.T) {
+		pending := messages(1)
+		pending[0].NextAttemptAt = time.Now().Add(time.Minute)
+
+		store := &fakeStore{locked: true, pending: pending, failed: map[int64]time.Time{}}
+		publisher := &fakePublisher{}
+
+		published, err := NewRelay(fakeTransactor{}, store, publisher, logger, config).RelayBatch(context.Background())
+		require.NoError(t, err)
+
+		assert.Zero(t, published)
+	})
+}
+
+func TestRelay_backoff(t *testing.T) {
+	relay := NewRelay(nil, nil, nil, nil, RelayConfig{PollInterval: time.Second, MaxBackoff: 5 * time.Second})
+
+	assert.Equal(t, time.Second, relay.backoff(0))
+	assert.Equal(t, 4*time.Second, relay.backoff(2))
+	assert.Equal(t, 5*time.Second, relay.backoff(10))
+}
@@ -0,0 +1,8 @@
+package ratelimit
+
+import "context"
+
+type Limiter interface {
+	// Allow records an attempt for key and reports whether it fits into the limit.
+	Allow(ctx context.Context, key string) (bool, error)
+}
@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/events"
+)
+
+type (
+	AdjustRating UseCase[*dto.AdjustRatingInputDTO, *dto.AdjustRatingOutputDTO]
+
+	adjustRating struct {
+		transactor  Transactor
+		adjuster    *ratingAdjuster
+		leaderboard user.Leaderboard
+	}
+)
+
+func NewAdjustRating(
+	transactor Transactor,
+	repository user.Repository,
+	ratingRepository user.RatingRepository,
+	leaderboard user.Leaderboard,
+	outbox events.Outbox,
+) AdjustRating {
+	return &adjustRating{
+		transactor: transactor,
+		adjuster: &ratingAdjuster{
+			userRepository:   repository,
+			ratingRepository: ratingRepository,
+			outbox:           outbox,
+		},
+		leaderboard: leaderboard,
+	}
+}
+
+func (uc *adjustRating) Execute(ctx context.Context, input *dto.AdjustRatingInputDTO) (*dto.AdjustRatingOutputDTO, error) {
+	errs := make(map[string]string)
+
+	timeControl := enums.TimeControl(input.TimeControl)
+	if !timeControl.IsValid() {
+		errs["time_control"] = "unknown time control"
+	}
+
+	switch {
+	case (input.Delta == nil) == (input.Value == nil):
+		errs["change"] = "exactly one of delta or value required"
+	case input.Delta != nil && *input.Delta == 0:
+		errs["delta"] = "delta must not be zero"
+	case input.Value != nil && (*input.Value < user.MinRating || *input.Value > user.MaxRating):
+		errs["value"] = "value must be between 100 and 4000"
+	}
+
+	validateRatingChange(errs, input.ActorID, input.Note)
+
+	if len(errs) > 0 {
+		return nil, apperrors.NewInvalidArgumentError("validation failed", errs)
+	}
+
+	var adjusted *adjustedRating
+
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		change := &ratingChange{
+			reason:  enums.ChangeReasonAdjustment,
+			actorID: input.ActorID,
+			note:    input.Note,
+		}
+
+		var err error
+
+		adjusted, err = uc.adjuster.apply(ctx, input.UserID, timeControl, change,
+			func(r *user.Rating) (int, error) {
+				if input.Delta != nil {
+					return r.Rating + *input.Delta, nil
+				}
+
+				return *input.Value, nil
+			})
+
+		return err
+	})
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	if adjusted.ranked {
+		if err = uc.leaderboard.Update(ctx, adjusted.rating); err != nil {
+			return nil, apperrors.FromDomainError(err)
+		}
+	}
+
+	return &dto.AdjustRatingOutputDTO{
+		Rating:  adjusted.rating,
+		History: adjusted.history,
+	}, nil
+}
@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/events"
+)
+
+const anonymizeDeletedUsersBatch = 100
+
+type (
+	AnonymizeDeletedUsers UseCase[struct{}, *dto.AnonymizeDeletedUsersOutputDTO]
+
+	anonymizeDeletedUsers struct {
+		sweep             *userSweep
+		userRepository    user.Repository
+		profileRepository user.ProfileRepository
+		outbox            events.Outbox
+		gracePeriod       time.Duration
+	}
+)
+
+// NewAnonymizeDeletedUsers erases the personal data of accounts deleted more than gracePeriod ago. Users keep
+// their row, id, ratings and rating history, so the game records of their opponents stay intact. A user restored
+// since it was found is left alone.
+func NewAnonymizeDeletedUsers(
+	transactor Transactor,
+	repository user.Repository,
+	profileRepository user.ProfileRepository,
+	outbox events.Outbox,
+	gracePeriod time.Duration,
+	logger logrus.FieldLogger,
+) AnonymizeDeletedUsers {
+	return &anonymizeDeletedUsers{
+		sweep: &userSweep{
+			transactor:     transactor,
+			userRepository: repository,
+			batchSize:      anonymizeDeletedUsersBatch,
+			logger:         logger,
+			failure:        "Failed to anonymize deleted user",
+		},
+		userRepository:    repository,
+		profileRepository: profileRepository,
+		outbox:            outbox,
+		gracePeriod:       gracePeriod,
+	}
+}
+
+func (uc *anonymizeDeletedUsers) Execute(ctx context.Context, _ struct{}) (*dto.AnonymizeDeletedUsersOutputDTO, error) {
+	status := enums.UserStatusDeleted
+	anonymized := false
+
+	done, failed, err := uc.sweep.run(ctx, func(now time.Time) *user.Criteria {
+		deletedBefore := now.Add(-uc.gracePeriod)
+
+		return &user.Criteria{
+			Status:        &status,
+			DeletedBefore: &deletedBefore,
+			Anonymized:    &anonymized,
+		}
+	}, uc.anonymize)
+
+	return &dto.AnonymizeDeletedUsersOutputDTO{
+		Anonymized: done,
+		Failed:     failed,
+	}, err
+}
+
+func (uc *anonymizeDeletedUsers) anonymize(ctx context.Context, u *user.User, now time.Time) error {
+	if u.AnonymizedAt() != nil || !u.DeletionExpired(now, uc.gracePeriod) {
+		return errSweepSkipped
+	}
+
+	if err := u.Anonymize(); err != nil {
+		return err
+	}
+
+	// Anonymize clears the password too, which Update leaves to UpdatePassword.
+	if err := uc.userRepository.UpdatePassword(ctx, u); err != nil {
+		return err
+	}
+
+	u, err := uc.userRepository.Update(ctx, u)
+	if err != nil {
+		return err
+	}
+
+	if err = uc.anonymizeProfile(ctx, u.ID()); err != nil {
+		return err
+	}
+
+	return uc.outbox.Append(ctx, &events.UserAnonymized{
+		UserID:       u.ID(),
+		AnonymizedAt: *u.AnonymizedAt(),
+	})
+}
+
+// anonymizeProfile erases the profile of the user. A user without a profile has nothing to erase there.
+func (uc *anonymizeDeletedUsers) anonymizeProfile(ctx context.Context, userID int64) error {
+	p, err := uc.profileRepository.GetByUserID(ctx, userID)
+	if errors.Is(err, domainerrors.ErrUserNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if err = p.Anonymize(); err != nil {
+		return err
+	}
+
+	return uc.profileRepository.Update(ctx, p)
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/events"
+)
+
+type (
+	BanUser UseCase[*dto.BanUserInputDTO, *dto.ModerateUserOutputDTO]
+
+	banUser struct {
+		transactor  Transactor
+		leaderboard user.Leaderboard
+		moderator   *moderator
+	}
+)
+
+func NewBanUser(
+	transactor Transactor,
+	repository user.Repository,
+	moderationRepository user.ModerationRepository,
+	leaderboard user.Leaderboard,
+	outbox events.Outbox,
+) BanUser {
+	return &banUser{
+		transactor:  transactor,
+		leaderboard: leaderboard,
+		moderator: &moderator{
+			userRepository:       repository,
+			moderationRepository: moderationRepository,
+			outbox:               outbox,
+		},
+	}
+}
+
+func (uc *banUser) Execute(ctx context.Context, input *dto.BanUserInputDTO) (*dto.ModerateUserOutputDTO, error) {
+	errs := make(map[string]string)
+
+	validateModeration(errs, input.ActorID, input.Reason)
+
+	if len(errs) > 0 {
+		return nil, apperrors.NewInvalidArgumentError("validation failed", errs)
+	}
+
+	var u *user.User
+
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		record := &user.ModerationRecord{
+			Action:  enums.ModerationActionBan,
+			ActorID: &input.ActorID,
+			Reason:  input.Reason,
+		}
+
+		var err error
+
+		u, err = uc.moderator.apply(ctx, input.UserID, record, func(u *user.User) error {
+			return u.Ban(input.Reason)
+		})
+
+		return err
+	})
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	// Banned players are not ranked, the leaderboard is only touched once the ban is committed.
+	if err = uc.leaderboard.Remove(ctx, u.ID()); err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	return &dto.ModerateUserOutputDTO{
+		User: u,
+	}, nil
+}
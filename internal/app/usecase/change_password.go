@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/password"
+)
+
+type (
+	ChangePassword UseCase[*dto.ChangePasswordInputDTO, *dto.ChangePasswordOutputDTO]
+
+	changePassword struct {
+		transactor      Transactor
+		userRepository  user.Repository
+		tokenRepository user.TokenRepository
+		hasher          password.Hasher
+	}
+)
+
+func NewChangePassword(
+	transactor Transactor,
+	repository user.Repository,
+	tokenRepository user.TokenRepository,
+	hasher password.Hasher,
+) ChangePassword {
+	return &changePassword{
+		transactor:      transactor,
+		userRepository:  repository,
+		tokenRepository: tokenRepository,
+		hasher:          hasher,
+	}
+}
+
+func (uc *changePassword) Execute(ctx context.Context, input *dto.ChangePasswordInputDTO) (*dto.ChangePasswordOutputDTO, error) {
+	errs := make(map[string]string)
+
+	if input.OldPassword == "" {
+		errs["old_password"] = "old password required"
+	}
+
+	plainPasswordVO, err := password.NewPlainPassword(input.NewPassword)
+	if err != nil {
+		errs["password"] = err.Error()
+	}
+
+	if len(errs) > 0 {
+		return nil, apperrors.NewInvalidArgumentError("validation failed", errs)
+	}
+
+	hashed, err := plainPasswordVO.Hash(uc.hasher)
+	if err != nil {
+		return nil, apperrors.NewInternalError("Failed to secure password.").WithCause(err)
+	}
+
+	err = uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		u, err := uc.userRepository.LockByID(ctx, input.UserID)
+		if err != nil {
+			return err
+		}
+
+		if err = u.EnsureCanLogin(); err != nil {
+			return err
+		}
+
+		if err = u.Password().Compare(input.OldPassword, uc.hasher); err != nil {
+			return domainerrors.ErrInvalidCredentials
+		}
+
+		u.ChangePassword(hashed)
+
+		return uc.userRepository.UpdatePassword(ctx, u)
+	})
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	if err = uc.tokenRepository.RevokeAll(ctx, input.UserID); err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	return &dto.ChangePasswordOutputDTO{
+		Message: "Password successfully changed.",
+	}, nil
+}
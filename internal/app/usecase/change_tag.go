@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/events"
+)
+
+type (
+	ChangeTag UseCase[*dto.ChangeTagInputDTO, *dto.ChangeTagOutputDTO]
+
+	changeTag struct {
+		transactor           Transactor
+		userRepository       user.Repository
+		userService          user.Service
+		tagHistoryRepository user.TagHistoryRepository
+		outbox               events.Outbox
+		cooldown             time.Duration
+		aliasTTL             time.Duration
+	}
+)
+
+// NewChangeTag allows one tag change per cooldown. The released tag stays an alias of the user for aliasTTL. The
+// tag is parsed and checked against the name policy by the user service.
+func NewChangeTag(
+	transactor Transactor,
+	repository user.Repository,
+	service user.Service,
+	tagHistoryRepository user.TagHistoryRepository,
+	outbox events.Outbox,
+	cooldown time.Duration,
+	aliasTTL time.Duration,
+) ChangeTag {
+	return &changeTag{
+		transactor:           transactor,
+		userRepository:       repository,
+		userService:          service,
+		tagHistoryRepository: tagHistoryRepository,
+		outbox:               outbox,
+		cooldown:             cooldown,
+		aliasTTL:             aliasTTL,
+	}
+}
+
+func (uc *changeTag) Execute(ctx context.Context, input *dto.ChangeTagInputDTO) (*dto.ChangeTagOutputDTO, error) {
+	var u *user.User
+
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+
+		u, err = uc.userRepository.LockByID(ctx, input.UserID)
+		if err != nil {
+			return err
+		}
+
+		lastChange, err := uc.tagHistoryRepository.LastReleasedAt(ctx, u.ID())
+		if err != nil {
+			return err
+		}
+
+		if lastChange != nil && time.Since(*lastChange) < uc.cooldown {
+			return apperrors.NewResourceExhaustedError("Tag was changed too recently. Try again later.")
+		}
+
+		newTag, err := uc.userService.BuildUserTag(ctx, u.ID(), &input.Tag)
+		if err != nil {
+			return err
+		}
+
+		oldTag := u.Tag().Value()
+
+		if err = u.ChangeTag(newTag); err != nil {
+			return err
+		}
+
+		u, err = uc.userRepository.Update(ctx, u)
+		if err != nil {
+			return err
+		}
+
+		err = uc.tagHistoryRepository.Create(ctx, &user.TagAlias{
+			UserID:    u.ID(),
+			Tag:       oldTag,
+			ExpiresAt: u.UpdatedAt().Add(uc.aliasTTL),
+		})
+		if err != nil {
+			return err
+		}
+
+		return uc.outbox.Append(ctx, &events.TagChanged{
+			UserID:    u.ID(),
+			OldTag:    oldTag,
+			NewTag:    u.Tag().Value(),
+			ChangedAt: u.UpdatedAt(),
+		})
+	})
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	return &dto.ChangeTagOutputDTO{
+		User: u,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/events"
+)
+
+type (
+	ConfirmEmail UseCase[*dto.ConfirmEmailInputDTO, *dto.ConfirmEmailOutputDTO]
+
+	confirmEmail struct {
+		transactor      Transactor
+		userRepository  user.Repository
+		tokenRepository user.TokenRepository
+		outbox          events.Outbox
+	}
+)
+
+func NewConfirmEmail(
+	transactor Transactor,
+	repository user.Repository,
+	tokenRepository user.TokenRepository,
+	outbox events.Outbox,
+) ConfirmEmail {
+	return &confirmEmail{
+		transactor:      transactor,
+		userRepository:  repository,
+		tokenRepository: tokenRepository,
+		outbox:          outbox,
+	}
+}
+
+func (uc *confirmEmail) Execute(ctx context.Context, input *dto.ConfirmEmailInputDTO) (*dto.ConfirmEmailOutputDTO, error) {
+	if input.Token == "" {
+		return nil, apperrors.NewInvalidArgumentError("validation failed", map[string]string{
+			"token": "token required",
+		})
+	}
+
+	userID, err := uc.tokenRepository.Consume(ctx, input.Token)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	var u *user.User
+
+	err = uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		u, err = uc.userRepository.GetByID(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		if err = u.VerifyEmail(); err != nil {
+			return err
+		}
+
+		u, err = uc.userRepository.Update(ctx, u)
+		if err != nil {
+			return err
+		}
+
+		return uc.outbox.Append(ctx, &events.UserVerified{
+			UserID:     u.ID(),
+			Email:      u.Email().Value(),
+			VerifiedAt: *u.EmailVerifiedAt(),
+		})
+	})
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	return &dto.ConfirmEmailOutputDTO{
+		User: u,
+	}, nil
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/password"
+)
+
+type (
+	ConfirmPasswordReset UseCase[*dto.ConfirmPasswordResetInputDTO, *dto.ConfirmPasswordResetOutputDTO]
+
+	confirmPasswordReset struct {
+		transactor      Transactor
+		userRepository  user.Repository
+		tokenRepository user.TokenRepository
+		hasher          password.Hasher
+	}
+)
+
+func NewConfirmPasswordReset(
+	transactor Transactor,
+	repository user.Repository,
+	tokenRepository user.TokenRepository,
+	hasher password.Hasher,
+) ConfirmPasswordReset {
+	return &confirmPasswordReset{
+		transactor:      transactor,
+		userRepository:  repository,
+		tokenRepository: tokenRepository,
+		hasher:          hasher,
+	}
+}
+
+func (uc *confirmPasswordReset) Execute(
+	ctx context.Context,
+	input *dto.ConfirmPasswordResetInputDTO,
+) (*dto.ConfirmPasswordResetOutputDTO, error) {
+	errs := make(map[string]string)
+
+	if input.Token == "" {
+		errs["token"] = "token required"
+	}
+
+	plainPasswordVO, err := password.NewPlainPassword(input.NewPassword)
+	if err != nil {
+		errs["password"] = err.Error()
+	}
+
+	if len(errs) > 0 {
+		return nil, apperrors.NewInvalidArgumentError("validation failed", errs)
+	}
+
+	hashed, err := plainPasswordVO.Hash(uc.hasher)
+	if err != nil {
+		return nil, apperrors.NewInternalError("Failed to secure password.").WithCause(err)
+	}
+
+	var userID int64
+
+	err = uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+
+		userID, err = uc.tokenRepository.Consume(ctx, input.Token)
+		if err != nil {
+			return err
+		}
+
+		u, err := uc.userRepository.LockByID(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		// The account may have been banned or deleted since the token was issued.
+		if err = u.EnsureCanLogin(); err != nil {
+			return err
+		}
+
+		u.ChangePassword(hashed)
+
+		return uc.userRepository.UpdatePassword(ctx, u)
+	})
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	if err = uc.tokenRepository.RevokeAll(ctx, userID); err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	return &dto.ConfirmPasswordResetOutputDTO{
+		Message: "Password successfully changed.",
+	}, nil
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/events"
+)
+
+const accountDeletedReason = "account deleted"
+
+type (
+	DeleteUser UseCase[*dto.DeleteUserInputDTO, *dto.DeleteUserOutputDTO]
+
+	deleteUser struct {
+		transactor     Transactor
+		userRepository user.Repository
+		leaderboard    user.Leaderboard
+		outbox         events.Outbox
+		gracePeriod    time.Duration
+	}
+)
+
+func NewDeleteUser(
+	transactor Transactor,
+	repository user.Repository,
+	leaderboard user.Leaderboard,
+	outbox events.Outbox,
+	gracePeriod time.Duration,
+) DeleteUser {
+	return &deleteUser{
+		transactor:     transactor,
+		userRepository: repository,
+		leaderboard:    leaderboard,
+		outbox:         outbox,
+		gracePeriod:    gracePeriod,
+	}
+}
+
+func (uc *deleteUser) Execute(ctx context.Context, input *dto.DeleteUserInputDTO) (*dto.DeleteUserOutputDTO, error) {
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		u, err := uc.userRepository.LockByID(ctx, input.UserID)
+		if err != nil {
+			return err
+		}
+
+		oldStatus := u.Status()
+
+		if err = u.Delete(); err != nil {
+			return err
+		}
+
+		u, err = uc.userRepository.Update(ctx, u)
+		if err != nil {
+			return err
+		}
+
+		return uc.outbox.Append(ctx, &events.UserStatusChanged{
+			UserID:    u.ID(),
+			OldStatus: oldStatus,
+			NewStatus: u.Status(),
+			Reason:    accountDeletedReason,
+			ChangedAt: u.UpdatedAt(),
+		})
+	})
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	if err = uc.leaderboard.Remove(ctx, input.UserID); err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	return &dto.DeleteUserOutputDTO{
+		Message:     "Account deleted. It can be restored until the grace period ends.",
+		GracePeriod: uc.gracePeriod,
+	}, nil
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/events"
+)
+
+const expirePremiumBatch = 100
+
+type (
+	ExpirePremium UseCase[struct{}, *dto.ExpirePremiumOutputDTO]
+
+	expirePremium struct {
+		sweep          *userSweep
+		userRepository user.Repository
+		outbox         events.Outbox
+	}
+)
+
+// NewExpirePremium turns premium off for users whose premium period has ended. A premium period extended since
+// the user was found is left alone.
+func NewExpirePremium(
+	transactor Transactor,
+	repository user.Repository,
+	outbox events.Outbox,
+	logger logrus.FieldLogger,
+) ExpirePremium {
+	return &expirePremium{
+		sweep: &userSweep{
+			transactor:     transactor,
+			userRepository: repository,
+			batchSize:      expirePremiumBatch,
+			logger:         logger,
+			failure:        "Failed to expire premium",
+		},
+		userRepository: repository,
+		outbox:         outbox,
+	}
+}
+
+func (uc *expirePremium) Execute(ctx context.Context, _ struct{}) (*dto.ExpirePremiumOutputDTO, error) {
+	isPremium := true
+
+	expired, failed, err := uc.sweep.run(ctx, func(now time.Time) *user.Criteria {
+		return &user.Criteria{
+			IsPremium:          &isPremium,
+			PremiumUntilBefore: &now,
+		}
+	}, uc.expire)
+
+	return &dto.ExpirePremiumOutputDTO{
+		Expired: expired,
+		Failed:  failed,
+	}, err
+}
+
+func (uc *expirePremium) expire(ctx context.Context, u *user.User, now time.Time) error {
+	if !u.PremiumExpired(now) {
+		return errSweepSkipped
+	}
+
+	if err := u.ExpirePremium(now); err != nil {
+		return err
+	}
+
+	u, err := uc.userRepository.Update(ctx, u)
+	if err != nil {
+		return err
+	}
+
+	return uc.outbox.Append(ctx, &events.PremiumChanged{
+		UserID:       u.ID(),
+		Change:       events.PremiumExpired,
+		IsPremium:    false,
+		PremiumUntil: u.PremiumUntil(),
+		ChangedAt:    u.UpdatedAt(),
+	})
+}
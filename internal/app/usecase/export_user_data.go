@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/export"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+)
+
+const exportHistoryBatch = 500
+
+type (
+	ExportUserData UseCase[*dto.ExportUserDataInputDTO, *dto.ExportUserDataOutputDTO]
+
+	exportUserData struct {
+		userRepository       user.Repository
+		profileRepository    user.ProfileRepository
+		ratingRepository     user.RatingRepository
+		moderationRepository user.ModerationRepository
+	}
+)
+
+// NewExportUserData assembles everything stored about a user into a versioned JSON document. Rating history is
+// read and written in batches, so the document can be sent while it is being built whatever its size.
+func NewExportUserData(
+	repository user.Repository,
+	profileRepository user.ProfileRepository,
+	ratingRepository user.RatingRepository,
+	moderationRepository user.ModerationRepository,
+) ExportUserData {
+	return &exportUserData{
+		userRepository:       repository,
+		profileRepository:    profileRepository,
+		ratingRepository:     ratingRepository,
+		moderationRepository: moderationRepository,
+	}
+}
+
+func (uc *exportUserData) Execute(ctx context.Context, input *dto.ExportUserDataInputDTO) (*dto.ExportUserDataOutputDTO, error) {
+	u, err := uc.userRepository.GetByID(ctx, input.UserID)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	profile, err := uc.profileRepository.GetByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	ratings, err := uc.ratingRepository.GetUserRatings(ctx, input.UserID)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	records, err := uc.moderationRepository.ListByUserID(ctx, input.UserID, 0)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	exportedRatings := make([]*export.Rating, 0, len(ratings))
+	for _, r := range ratings {
+		exportedRatings = append(exportedRatings, export.NewRating(r))
+	}
+
+	exportedRecords := make([]*export.ModerationRecord, 0, len(records))
+	for _, r := range records {
+		exportedRecords = append(exportedRecords, export.NewModerationRecord(r))
+	}
+
+	w := export.NewWriter(input.Output)
+
+	_ = w.Field("version", export.FormatVersion)
+	_ = w.Field("generated_at", time.Now().UTC())
+	_ = w.Field("user", export.NewUser(u))
+	_ = w.Field("profile", export.NewProfile(profile))
+	_ = w.Field("login", export.NewLogin(u))
+	_ = w.Field("ratings", exportedRatings)
+	_ = w.Field("moderation", exportedRecords)
+
+	if err = w.BeginArray("rating_history"); err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	output := &dto.ExportUserDataOutputDTO{
+		Version: export.FormatVersion,
+	}
+
+	var after *user.RatingHistoryCursor
+
+	for {
+		history, err := uc.ratingRepository.ListRatingHistory(ctx, input.UserID, after, exportHistoryBatch)
+		if err != nil {
+			return nil, apperrors.FromDomainError(err)
+		}
+
+		for _, h := range history {
+			if err = w.Element(export.NewRatingHistory(h)); err != nil {
+				return nil, apperrors.FromDomainError(err)
+			}
+		}
+
+		output.HistoryEntries += len(history)
+
+		if len(history) < exportHistoryBatch {
+			break
+		}
+
+		last := history[len(history)-1]
+		after = &user.RatingHistoryCursor{CreatedAt: last.CreatedAt, ID: last.Id}
+	}
+
+	_ = w.EndArray()
+
+	if err = w.Close(); err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	return output, nil
+}
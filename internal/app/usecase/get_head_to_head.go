@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+)
+
+type (
+	GetHeadToHead UseCase[*dto.GetHeadToHeadInputDTO, *dto.GetHeadToHeadOutputDTO]
+
+	getHeadToHead struct {
+		repository           user.Repository
+		headToHeadRepository user.HeadToHeadRepository
+	}
+)
+
+func NewGetHeadToHead(repository user.Repository, headToHeadRepository user.HeadToHeadRepository) GetHeadToHead {
+	return &getHeadToHead{
+		repository:           repository,
+		headToHeadRepository: headToHeadRepository,
+	}
+}
+
+func (uc *getHeadToHead) Execute(
+	ctx context.Context,
+	input *dto.GetHeadToHeadInputDTO,
+) (*dto.GetHeadToHeadOutputDTO, error) {
+	errs := make(map[string]string)
+
+	if input.UserID <= 0 {
+		errs["user_id"] = "user id required"
+	}
+
+	switch {
+	case input.OpponentID <= 0:
+		errs["opponent_id"] = "opponent id required"
+	case input.OpponentID == input.UserID:
+		errs["opponent_id"] = "players must be different users"
+	}
+
+	if len(errs) > 0 {
+		return nil, apperrors.NewInvalidArgumentError("validation failed", errs)
+	}
+
+	for _, userID := range []int64{input.UserID, input.OpponentID} {
+		if _, err := uc.repository.GetByID(ctx, userID); err != nil {
+			return nil, apperrors.FromDomainError(err)
+		}
+	}
+
+	records, err := uc.headToHeadRepository.GetBetween(ctx, input.UserID, input.OpponentID)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	total := &user.HeadToHead{UserID: input.UserID, OpponentID: input.OpponentID}
+	for _, r := range records {
+		total.Merge(r)
+	}
+
+	return &dto.GetHeadToHeadOutputDTO{
+		Records: records,
+		Total:   total,
+	}, nil
+}
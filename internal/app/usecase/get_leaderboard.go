@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+)
+
+const (
+	defaultLeaderboardLimit = 50
+	maxLeaderboardLimit     = 100
+)
+
+type (
+	GetLeaderboard UseCase[*dto.GetLeaderboardInputDTO, *dto.GetLeaderboardOutputDTO]
+
+	getLeaderboard struct {
+		leaderboard      user.Leaderboard
+		ratingRepository user.RatingRepository
+	}
+)
+
+func NewGetLeaderboard(leaderboard user.Leaderboard, ratingRepository user.RatingRepository) GetLeaderboard {
+	return &getLeaderboard{
+		leaderboard:      leaderboard,
+		ratingRepository: ratingRepository,
+	}
+}
+
+func (uc *getLeaderboard) Execute(
+	ctx context.Context,
+	input *dto.GetLeaderboardInputDTO,
+) (*dto.GetLeaderboardOutputDTO, error) {
+	errs := make(map[string]string)
+
+	timeControl := enums.TimeControl(input.TimeControl)
+	if !timeControl.IsValid() {
+		errs["time_control"] = "unknown time control"
+	}
+
+	if input.Limit < 0 || input.Limit > maxLeaderboardLimit {
+		errs["limit"] = "limit must be between 1 and 100"
+	}
+
+	if len(errs) > 0 {
+		return nil, apperrors.NewInvalidArgumentError("validation failed", errs)
+	}
+
+	limit := input.Limit
+	if limit == 0 {
+		limit = defaultLeaderboardLimit
+	}
+
+	entries, err := uc.leaderboard.Page(ctx, timeControl, input.After, limit)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	if err = hydrateLeaderboard(ctx, uc.ratingRepository, timeControl, entries); err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	output := &dto.GetLeaderboardOutputDTO{
+		Entries: entries,
+	}
+
+	if len(entries) == limit {
+		output.Next = entries[len(entries)-1].Cursor()
+	}
+
+	return output, nil
+}
+
+// hydrateLeaderboard replaces the bare ratings kept by the leaderboard with the full ones from the repository,
+// keeping the ranked value so an entry never disagrees with its rank.
+func hydrateLeaderboard(
+	ctx context.Context,
+	ratingRepository user.RatingRepository,
+	timeControl enums.TimeControl,
+	entries []*user.LeaderboardEntry,
+) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	userIDs := make([]int64, 0, len(entries))
+	for _, e := range entries {
+		userIDs = append(userIDs, e.Rating.UserID)
+	}
+
+	ratings, err := ratingRepository.GetRatingsByUserIDs(ctx, timeControl, userIDs)
+	if err != nil {
+		return err
+	}
+
+	byUserID := make(map[int64]*user.Rating, len(ratings))
+	for _, r := range ratings {
+		byUserID[r.UserID] = r
+	}
+
+	for _, e := range entries {
+		if full, ok := byUserID[e.Rating.UserID]; ok {
+			full.Rating = e.Rating.Rating
+			e.Rating = full
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+)
+
+const (
+	defaultLeaderboardRadius = 5
+	maxLeaderboardRadius     = 50
+)
+
+type (
+	GetLeaderboardAroundUser UseCase[*dto.GetLeaderboardAroundUserInputDTO, *dto.GetLeaderboardAroundUserOutputDTO]
+
+	getLeaderboardAroundUser struct {
+		leaderboard      user.Leaderboard
+		ratingRepository user.RatingRepository
+	}
+)
+
+func NewGetLeaderboardAroundUser(
+	leaderboard user.Leaderboard,
+	ratingRepository user.RatingRepository,
+) GetLeaderboardAroundUser {
+	return &getLeaderboardAroundUser{
+		leaderboard:      leaderboard,
+		ratingRepository: ratingRepository,
+	}
+}
+
+func (uc *getLeaderboardAroundUser) Execute(
+	ctx context.Context,
+	input *dto.GetLeaderboardAroundUserInputDTO,
+) (*dto.GetLeaderboardAroundUserOutputDTO, error) {
+	errs := make(map[string]string)
+
+	timeControl := enums.TimeControl(input.TimeControl)
+	if !timeControl.IsValid() {
+		errs["time_control"] = "unknown time control"
+	}
+
+	if input.Radius < 0 || input.Radius > maxLeaderboardRadius {
+		errs["radius"] = "radius must be between 1 and 50"
+	}
+
+	if len(errs) > 0 {
+		return nil, apperrors.NewInvalidArgumentError("validation failed", errs)
+	}
+
+	radius := input.Radius
+	if radius == 0 {
+		radius = defaultLeaderboardRadius
+	}
+
+	entries, err := uc.leaderboard.Around(ctx, timeControl, input.UserID, radius)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	if err = hydrateLeaderboard(ctx, uc.ratingRepository, timeControl, entries); err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	return &dto.GetLeaderboardAroundUserOutputDTO{
+		Entries: entries,
+	}, nil
+}
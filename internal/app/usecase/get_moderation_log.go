@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+)
+
+const (
+	defaultModerationLogLimit = 20
+	maxModerationLogLimit     = 100
+)
+
+type (
+	GetModerationLog UseCase[*dto.GetModerationLogInputDTO, *dto.GetModerationLogOutputDTO]
+
+	getModerationLog struct {
+		repository           user.Repository
+		moderationRepository user.ModerationRepository
+	}
+)
+
+func NewGetModerationLog(repository user.Repository, moderationRepository user.ModerationRepository) GetModerationLog {
+	return &getModerationLog{
+		repository:           repository,
+		moderationRepository: moderationRepository,
+	}
+}
+
+func (uc *getModerationLog) Execute(
+	ctx context.Context,
+	input *dto.GetModerationLogInputDTO,
+) (*dto.GetModerationLogOutputDTO, error) {
+	limit := input.Limit
+	if limit == 0 {
+		limit = defaultModerationLogLimit
+	}
+
+	if limit < 0 || limit > maxModerationLogLimit {
+		return nil, apperrors.NewInvalidArgumentError("validation failed", map[string]string{
+			"limit": "limit must be between 1 and 100",
+		})
+	}
+
+	if _, err := uc.repository.GetByID(ctx, input.UserID); err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	records, err := uc.moderationRepository.ListByUserID(ctx, input.UserID, limit)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	return &dto.GetModerationLogOutputDTO{
+		Records: records,
+	}, nil
+}
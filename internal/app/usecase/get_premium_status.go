@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+)
+
+type (
+	GetPremiumStatus UseCase[*dto.GetPremiumStatusInputDTO, *dto.GetPremiumStatusOutputDTO]
+
+	getPremiumStatus struct {
+		userRepository user.Repository
+	}
+)
+
+// premiumStatusClockSkew is how far in the past an instant may be and still be answered as now, so callers passing
+// their own clock are not rejected over latency.
+const premiumStatusClockSkew = time.Minute
+
+// NewGetPremiumStatus answers from the current premium period only, earlier periods are not kept, so instants in
+// the past are rejected.
+func NewGetPremiumStatus(repository user.Repository) GetPremiumStatus {
+	return &getPremiumStatus{
+		userRepository: repository,
+	}
+}
+
+func (uc *getPremiumStatus) Execute(
+	ctx context.Context,
+	input *dto.GetPremiumStatusInputDTO,
+) (*dto.GetPremiumStatusOutputDTO, error) {
+	errs := make(map[string]string)
+	now := time.Now()
+	at := now
+
+	if input.At != nil {
+		if input.At.Before(now.Add(-premiumStatusClockSkew)) {
+			errs["at"] = "instant must not be in the past"
+		}
+
+		if input.At.After(now) {
+			at = *input.At
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, apperrors.NewInvalidArgumentError("validation failed", errs)
+	}
+
+	u, err := uc.userRepository.GetByID(ctx, input.UserID)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	return &dto.GetPremiumStatusOutputDTO{
+		IsPremium:    u.IsPremiumAt(at),
+		PremiumUntil: u.PremiumUntil(),
+	}, nil
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+)
+
+type (
+	GetProfile UseCase[*dto.GetProfileInputDTO, *dto.GetProfileOutputDTO]
+
+	getProfile struct {
+		profileRepository user.ProfileRepository
+	}
+)
+
+// NewGetProfile returns the whole profile as its owner edits it, GetPublicProfiles is what other users see.
+func NewGetProfile(profileRepository user.ProfileRepository) GetProfile {
+	return &getProfile{
+		profileRepository: profileRepository,
+	}
+}
+
+func (uc *getProfile) Execute(ctx context.Context, input *dto.GetProfileInputDTO) (*dto.GetProfileOutputDTO, error) {
+	profile, err := uc.profileRepository.GetByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	return &dto.GetProfileOutputDTO{
+		Profile: profile,
+	}, nil
+}
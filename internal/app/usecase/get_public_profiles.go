@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+)
+
+const maxPublicProfilesBatch = 500
+
+type (
+	GetPublicProfiles UseCase[*dto.GetPublicProfilesInputDTO, *dto.GetPublicProfilesOutputDTO]
+
+	getPublicProfiles struct {
+		profileRepository user.ProfileRepository
+	}
+)
+
+func NewGetPublicProfiles(profileRepository user.ProfileRepository) GetPublicProfiles {
+	return &getPublicProfiles{
+		profileRepository: profileRepository,
+	}
+}
+
+func (uc *getPublicProfiles) Execute(
+	ctx context.Context,
+	input *dto.GetPublicProfilesInputDTO,
+) (*dto.GetPublicProfilesOutputDTO, error) {
+	userIDs := make([]int64, 0, len(input.UserIDs))
+	seen := make(map[int64]struct{}, len(input.UserIDs))
+
+	for _, id := range input.UserIDs {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+
+		seen[id] = struct{}{}
+		userIDs = append(userIDs, id)
+	}
+
+	if len(userIDs) > maxPublicProfilesBatch {
+		return nil, apperrors.NewInvalidArgumentError("validation failed", map[string]string{
+			"user_ids": "at most 500 user ids can be requested at once",
+		})
+	}
+
+	profiles, err := uc.profileRepository.GetPublicProfiles(ctx, userIDs)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	return &dto.GetPublicProfilesOutputDTO{
+		Profiles: profiles,
+	}, nil
+}
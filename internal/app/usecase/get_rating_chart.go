@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+)
+
+const (
+	recentChartDays = 90
+	maxChartBuckets = 1000
+)
+
+// chartIntervalLengths are the shortest length of each interval, used to bound the number of buckets of a range.
+var chartIntervalLengths = map[enums.ChartInterval]time.Duration{
+	enums.ChartIntervalDay:   24 * time.Hour,
+	enums.ChartIntervalWeek:  7 * 24 * time.Hour,
+	enums.ChartIntervalMonth: 28 * 24 * time.Hour,
+}
+
+type (
+	GetRatingChart UseCase[*dto.GetRatingChartInputDTO, *dto.GetRatingChartOutputDTO]
+
+	getRatingChart struct {
+		repository       user.Repository
+		ratingRepository user.RatingRepository
+	}
+)
+
+func NewGetRatingChart(repository user.Repository, ratingRepository user.RatingRepository) GetRatingChart {
+	return &getRatingChart{
+		repository:       repository,
+		ratingRepository: ratingRepository,
+	}
+}
+
+func (uc *getRatingChart) Execute(ctx context.Context, input *dto.GetRatingChartInputDTO) (*dto.GetRatingChartOutputDTO, error) {
+	errs := make(map[string]string)
+
+	timeControl := enums.TimeControl(input.TimeControl)
+	if !timeControl.IsValid() {
+		errs["time_control"] = "unknown time control"
+	}
+
+	interval := enums.ChartInterval(input.Interval)
+	if interval == "" {
+		interval = enums.ChartIntervalDay
+	}
+
+	if !interval.IsValid() {
+		errs["interval"] = "unknown interval"
+	}
+
+	recent := input.From == nil && input.To == nil && interval == enums.ChartIntervalDay
+
+	to := time.Now()
+	if input.To != nil {
+		to = *input.To
+	}
+
+	from := to.AddDate(0, 0, -recentChartDays)
+	if input.From != nil {
+		from = *input.From
+	}
+
+	if !from.Before(to) {
+		errs["from"] = "from must be before to"
+	} else if length, ok := chartIntervalLengths[interval]; ok && to.Sub(from)/length > maxChartBuckets {
+		errs["from"] = "range has too many buckets for the interval"
+	}
+
+	if len(errs) > 0 {
+		return nil, apperrors.NewInvalidArgumentError("validation failed", errs)
+	}
+
+	if _, err := uc.repository.GetByID(ctx, input.UserID); err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	var (
+		buckets []*user.RatingBucket
+		err     error
+	)
+
+	// The default view of a profile page, served from a cache that follows rating changes.
+	if recent {
+		buckets, err = uc.ratingRepository.GetRecentRatingBuckets(ctx, input.UserID, timeControl, recentChartDays)
+	} else {
+		buckets, err = uc.ratingRepository.GetRatingBuckets(ctx, input.UserID, timeControl, interval, from, to)
+	}
+
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	return &dto.GetRatingChartOutputDTO{
+		Interval: string(interval),
+		Buckets:  buckets,
+	}, nil
+}
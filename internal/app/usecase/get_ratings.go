@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+)
+
+const maxHistoryLimit = 100
+
+type (
+	GetRatings UseCase[*dto.GetRatingsInputDTO, *dto.GetRatingsOutputDTO]
+
+	getRatings struct {
+		repository       user.Repository
+		ratingRepository user.RatingRepository
+	}
+)
+
+func NewGetRatings(repository user.Repository, ratingRepository user.RatingRepository) GetRatings {
+	return &getRatings{
+		repository:       repository,
+		ratingRepository: ratingRepository,
+	}
+}
+
+func (uc *getRatings) Execute(ctx context.Context, input *dto.GetRatingsInputDTO) (*dto.GetRatingsOutputDTO, error) {
+	errs := make(map[string]string)
+
+	timeControl := enums.TimeControl(input.TimeControl)
+	if timeControl != "" && !timeControl.IsValid() {
+		errs["time_control"] = "unknown time control"
+	}
+
+	if input.HistoryLimit < 0 || input.HistoryLimit > maxHistoryLimit {
+		errs["history_limit"] = "history limit must be between 0 and 100"
+	}
+
+	if len(errs) > 0 {
+		return nil, apperrors.NewInvalidArgumentError("validation failed", errs)
+	}
+
+	if _, err := uc.repository.GetByID(ctx, input.UserID); err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	ratings, err := uc.ratingRepository.GetUserRatings(ctx, input.UserID)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	output := &dto.GetRatingsOutputDTO{
+		Ratings: make([]*user.Rating, 0, len(ratings)),
+		History: []*user.RatingHistory{},
+	}
+
+	for _, r := range ratings {
+		if timeControl != "" && r.TimeControl != timeControl {
+			continue
+		}
+
+		output.Ratings = append(output.Ratings, r)
+
+		if input.HistoryLimit == 0 {
+			continue
+		}
+
+		history, err := uc.ratingRepository.GetRatingHistory(ctx, input.UserID, r.TimeControl, input.HistoryLimit)
+		if err != nil {
+			return nil, apperrors.FromDomainError(err)
+		}
+
+		output.History = append(output.History, history...)
+	}
+
+	return output, nil
+}
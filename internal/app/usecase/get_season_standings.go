@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+)
+
+type (
+	GetSeasonStandings UseCase[*dto.GetSeasonStandingsInputDTO, *dto.GetSeasonStandingsOutputDTO]
+
+	getSeasonStandings struct {
+		seasonRepository user.SeasonRepository
+	}
+)
+
+func NewGetSeasonStandings(seasonRepository user.SeasonRepository) GetSeasonStandings {
+	return &getSeasonStandings{
+		seasonRepository: seasonRepository,
+	}
+}
+
+func (uc *getSeasonStandings) Execute(
+	ctx context.Context,
+	input *dto.GetSeasonStandingsInputDTO,
+) (*dto.GetSeasonStandingsOutputDTO, error) {
+	errs := make(map[string]string)
+
+	if input.SeasonID <= 0 {
+		errs["season_id"] = "season id required"
+	}
+
+	timeControl := enums.TimeControl(input.TimeControl)
+	if !timeControl.IsValid() {
+		errs["time_control"] = "unknown time control"
+	}
+
+	if input.Limit < 0 || input.Limit > maxLeaderboardLimit {
+		errs["limit"] = "limit must be between 1 and 100"
+	}
+
+	if len(errs) > 0 {
+		return nil, apperrors.NewInvalidArgumentError("validation failed", errs)
+	}
+
+	limit := input.Limit
+	if limit == 0 {
+		limit = defaultLeaderboardLimit
+	}
+
+	if _, err := uc.seasonRepository.GetByID(ctx, input.SeasonID); err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	standings, err := uc.seasonRepository.GetStandings(ctx, input.SeasonID, timeControl, input.After, limit)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	output := &dto.GetSeasonStandingsOutputDTO{
+		Standings: standings,
+	}
+
+	if len(standings) == limit {
+		last := standings[len(standings)-1]
+		output.Next = &user.LeaderboardCursor{Rating: last.Rating, UserID: last.UserID}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+)
+
+const (
+	defaultTopOpponentsLimit = 10
+	maxTopOpponentsLimit     = 100
+)
+
+type (
+	GetTopOpponents UseCase[*dto.GetTopOpponentsInputDTO, *dto.GetTopOpponentsOutputDTO]
+
+	getTopOpponents struct {
+		repository           user.Repository
+		headToHeadRepository user.HeadToHeadRepository
+	}
+)
+
+func NewGetTopOpponents(repository user.Repository, headToHeadRepository user.HeadToHeadRepository) GetTopOpponents {
+	return &getTopOpponents{
+		repository:           repository,
+		headToHeadRepository: headToHeadRepository,
+	}
+}
+
+func (uc *getTopOpponents) Execute(
+	ctx context.Context,
+	input *dto.GetTopOpponentsInputDTO,
+) (*dto.GetTopOpponentsOutputDTO, error) {
+	errs := make(map[string]string)
+
+	timeControl := enums.TimeControl(input.TimeControl)
+	if timeControl != "" && !timeControl.IsValid() {
+		errs["time_control"] = "unknown time control"
+	}
+
+	if input.Limit < 0 || input.Limit > maxTopOpponentsLimit {
+		errs["limit"] = "limit must be between 1 and 100"
+	}
+
+	if len(errs) > 0 {
+		return nil, apperrors.NewInvalidArgumentError("validation failed", errs)
+	}
+
+	limit := input.Limit
+	if limit == 0 {
+		limit = defaultTopOpponentsLimit
+	}
+
+	if _, err := uc.repository.GetByID(ctx, input.UserID); err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	opponents, err := uc.headToHeadRepository.ListOpponents(ctx, input.UserID, timeControl, limit)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	return &dto.GetTopOpponentsOutputDTO{
+		Opponents: opponents,
+	}, nil
+}
@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+)
+
+type (
+	GetUser UseCase[*dto.GetUserInputDTO, *dto.GetUserOutputDTO]
+
+	getUser struct {
+		userRepository user.Repository
+	}
+)
+
+func NewGetUser(repository user.Repository) GetUser {
+	return &getUser{
+		userRepository: repository,
+	}
+}
+
+func (uc *getUser) Execute(ctx context.Context, input *dto.GetUserInputDTO) (*dto.GetUserOutputDTO, error) {
+	u, err := uc.userRepository.GetByID(ctx, input.UserID)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	return &dto.GetUserOutputDTO{
+		User: u,
+	}, nil
+}
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+)
+
+type (
+	GetUserByTag UseCase[*dto.GetUserByTagInputDTO, *dto.GetUserByTagOutputDTO]
+
+	getUserByTag struct {
+		userRepository       user.Repository
+		tagHistoryRepository user.TagHistoryRepository
+	}
+)
+
+// NewGetUserByTag falls back to the tags users have changed away from while they are still held as aliases.
+func NewGetUserByTag(repository user.Repository, tagHistoryRepository user.TagHistoryRepository) GetUserByTag {
+	return &getUserByTag{
+		userRepository:       repository,
+		tagHistoryRepository: tagHistoryRepository,
+	}
+}
+
+func (uc *getUserByTag) Execute(ctx context.Context, input *dto.GetUserByTagInputDTO) (*dto.GetUserByTagOutputDTO, error) {
+	if input.Tag == "" {
+		return nil, apperrors.NewInvalidArgumentError("validation failed", map[string]string{
+			"tag": "tag required",
+		})
+	}
+
+	u, err := uc.userRepository.GetByTag(ctx, input.Tag)
+	if err == nil {
+		return &dto.GetUserByTagOutputDTO{
+			User: u,
+		}, nil
+	}
+
+	if !errors.Is(err, domainerrors.ErrUserNotFound) {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	alias, err := uc.tagHistoryRepository.GetActive(ctx, input.Tag, time.Now())
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	u, err = uc.userRepository.GetByID(ctx, alias.UserID)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	return &dto.GetUserByTagOutputDTO{
+		User:           u,
+		RedirectedFrom: input.Tag,
+	}, nil
+}
@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+)
+
+type (
+	GetUserRank UseCase[*dto.GetUserRankInputDTO, *dto.GetUserRankOutputDTO]
+
+	getUserRank struct {
+		leaderboard user.Leaderboard
+	}
+)
+
+func NewGetUserRank(leaderboard user.Leaderboard) GetUserRank {
+	return &getUserRank{
+		leaderboard: leaderboard,
+	}
+}
+
+func (uc *getUserRank) Execute(ctx context.Context, input *dto.GetUserRankInputDTO) (*dto.GetUserRankOutputDTO, error) {
+	timeControl := enums.TimeControl(input.TimeControl)
+	if !timeControl.IsValid() {
+		return nil, apperrors.NewInvalidArgumentError("validation failed", map[string]string{
+			"time_control": "unknown time control",
+		})
+	}
+
+	rank, err := uc.leaderboard.Rank(ctx, timeControl, input.UserID)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	return &dto.GetUserRankOutputDTO{
+		Rank: rank,
+	}, nil
+}
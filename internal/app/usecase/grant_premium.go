@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/events"
+)
+
+type (
+	GrantPremium UseCase[*dto.GrantPremiumInputDTO, *dto.PremiumOutputDTO]
+
+	grantPremium struct {
+		transactor     Transactor
+		userRepository user.Repository
+		outbox         events.Outbox
+	}
+)
+
+func NewGrantPremium(transactor Transactor, repository user.Repository, outbox events.Outbox) GrantPremium {
+	return &grantPremium{
+		transactor:     transactor,
+		userRepository: repository,
+		outbox:         outbox,
+	}
+}
+
+func (uc *grantPremium) Execute(ctx context.Context, input *dto.GrantPremiumInputDTO) (*dto.PremiumOutputDTO, error) {
+	if input.Duration <= 0 {
+		return nil, apperrors.NewInvalidArgumentError("validation failed", map[string]string{
+			"duration": "duration must be positive",
+		})
+	}
+
+	var u *user.User
+
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+
+		u, err = uc.userRepository.LockByID(ctx, input.UserID)
+		if err != nil {
+			return err
+		}
+
+		// Premium without an end has nothing to extend.
+		if u.IsPremium() && u.PremiumUntil() == nil {
+			return nil
+		}
+
+		change := events.PremiumGranted
+		if u.IsPremiumAt(time.Now()) {
+			change = events.PremiumExtended
+		}
+
+		if err = u.GrantPremium(input.Duration); err != nil {
+			return err
+		}
+
+		u, err = uc.userRepository.Update(ctx, u)
+		if err != nil {
+			return err
+		}
+
+		return uc.outbox.Append(ctx, &events.PremiumChanged{
+			UserID:       u.ID(),
+			Change:       change,
+			IsPremium:    u.IsPremium(),
+			PremiumUntil: u.PremiumUntil(),
+			ChangedAt:    u.UpdatedAt(),
+		})
+	})
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	return &dto.PremiumOutputDTO{
+		User: u,
+	}, nil
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/events"
+)
+
+const (
+	liftExpiredSuspensionsBatch = 100
+	suspensionExpiredReason     = "suspension expired"
+)
+
+type (
+	LiftExpiredSuspensions UseCase[struct{}, *dto.LiftExpiredSuspensionsOutputDTO]
+
+	liftExpiredSuspensions struct {
+		sweep     *userSweep
+		moderator *moderator
+	}
+)
+
+// NewLiftExpiredSuspensions reinstates users whose suspension has run out. A suspension extended or turned into
+// a ban since the user was found is left alone.
+func NewLiftExpiredSuspensions(
+	transactor Transactor,
+	repository user.Repository,
+	moderationRepository user.ModerationRepository,
+	outbox events.Outbox,
+	logger logrus.FieldLogger,
+) LiftExpiredSuspensions {
+	return &liftExpiredSuspensions{
+		sweep: &userSweep{
+			transactor:     transactor,
+			userRepository: repository,
+			batchSize:      liftExpiredSuspensionsBatch,
+			logger:         logger,
+			failure:        "Failed to lift expired suspension",
+		},
+		moderator: &moderator{
+			userRepository:       repository,
+			moderationRepository: moderationRepository,
+			outbox:               outbox,
+		},
+	}
+}
+
+func (uc *liftExpiredSuspensions) Execute(ctx context.Context, _ struct{}) (*dto.LiftExpiredSuspensionsOutputDTO, error) {
+	status := enums.UserStatusSuspended
+
+	lifted, failed, err := uc.sweep.run(ctx, func(now time.Time) *user.Criteria {
+		return &user.Criteria{
+			Status:               &status,
+			SuspendedUntilBefore: &now,
+		}
+	}, uc.lift)
+
+	return &dto.LiftExpiredSuspensionsOutputDTO{
+		Lifted: lifted,
+		Failed: failed,
+	}, err
+}
+
+func (uc *liftExpiredSuspensions) lift(ctx context.Context, u *user.User, now time.Time) error {
+	record := &user.ModerationRecord{
+		Action: enums.ModerationActionReinstate,
+		Reason: suspensionExpiredReason,
+	}
+
+	_, err := uc.moderator.applyLocked(ctx, u, record, func(u *user.User) error {
+		if !u.SuspensionExpired(now) {
+			return errSweepSkipped
+		}
+
+		return u.Reinstate()
+	})
+
+	return err
+}
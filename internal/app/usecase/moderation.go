@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/events"
+)
+
+const maxModerationReasonLen = 1000
+
+// moderator applies status changes together with their moderation log entry and event. It must be used inside
+// a transaction.
+type moderator struct {
+	userRepository       user.Repository
+	moderationRepository user.ModerationRepository
+	outbox               events.Outbox
+}
+
+// apply runs change on the locked user and records it. The record is completed with the user id and the end of
+// the suspension, if any.
+func (m *moderator) apply(
+	ctx context.Context,
+	userID int64,
+	record *user.ModerationRecord,
+	change func(u *user.User) error,
+) (*user.User, error) {
+	u, err := m.userRepository.LockByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.applyLocked(ctx, u, record, change)
+}
+
+// applyLocked is apply for a user the caller has locked already.
+func (m *moderator) applyLocked(
+	ctx context.Context,
+	u *user.User,
+	record *user.ModerationRecord,
+	change func(u *user.User) error,
+) (*user.User, error) {
+	oldStatus := u.Status()
+
+	if err := change(u); err != nil {
+		return nil, err
+	}
+
+	u, err := m.userRepository.Update(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	record.UserID = u.ID()
+	record.SuspendedUntil = u.SuspendedUntil()
+
+	if err = m.moderationRepository.Create(ctx, record); err != nil {
+		return nil, err
+	}
+
+	// Extending a suspension is logged but is not a status change.
+	if oldStatus == u.Status() {
+		return u, nil
+	}
+
+	return u, m.outbox.Append(ctx, &events.UserStatusChanged{
+		UserID:    u.ID(),
+		OldStatus: oldStatus,
+		NewStatus: u.Status(),
+		Reason:    record.Reason,
+		ChangedAt: u.UpdatedAt(),
+	})
+}
+
+func validateModeration(errs map[string]string, actorID int64, reason string) {
+	if actorID <= 0 {
+		errs["actor_id"] = "actor id required"
+	}
+
+	switch {
+	case strings.TrimSpace(reason) == "":
+		errs["reason"] = "reason required"
+	case len(reason) > maxModerationReasonLen:
+		errs["reason"] = "value is too long"
+	}
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/events"
+)
+
+type (
+	PenalizeRating UseCase[*dto.PenalizeRatingInputDTO, *dto.AdjustRatingOutputDTO]
+
+	penalizeRating struct {
+		transactor  Transactor
+		adjuster    *ratingAdjuster
+		leaderboard user.Leaderboard
+	}
+)
+
+// NewPenalizeRating takes fair-play penalties. A penalty never takes the rating below user.MinRating.
+func NewPenalizeRating(
+	transactor Transactor,
+	repository user.Repository,
+	ratingRepository user.RatingRepository,
+	leaderboard user.Leaderboard,
+	outbox events.Outbox,
+) PenalizeRating {
+	return &penalizeRating{
+		transactor: transactor,
+		adjuster: &ratingAdjuster{
+			userRepository:   repository,
+			ratingRepository: ratingRepository,
+			outbox:           outbox,
+		},
+		leaderboard: leaderboard,
+	}
+}
+
+func (uc *penalizeRating) Execute(ctx context.Context, input *dto.PenalizeRatingInputDTO) (*dto.AdjustRatingOutputDTO, error) {
+	errs := make(map[string]string)
+
+	timeControl := enums.TimeControl(input.TimeControl)
+	if !timeControl.IsValid() {
+		errs["time_control"] = "unknown time control"
+	}
+
+	if input.Points <= 0 {
+		errs["points"] = "points must be positive"
+	}
+
+	validateRatingChange(errs, input.ActorID, input.Note)
+
+	if len(errs) > 0 {
+		return nil, apperrors.NewInvalidArgumentError("validation failed", errs)
+	}
+
+	var adjusted *adjustedRating
+
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		change := &ratingChange{
+			reason:  enums.ChangeReasonPenalty,
+			actorID: input.ActorID,
+			note:    input.Note,
+		}
+
+		var err error
+
+		adjusted, err = uc.adjuster.apply(ctx, input.UserID, timeControl, change,
+			func(r *user.Rating) (int, error) {
+				if r.Rating <= user.MinRating {
+					return 0, domainerrors.ErrInvalidRatingChange
+				}
+
+				return max(r.Rating-input.Points, user.MinRating), nil
+			})
+
+		return err
+	})
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	if adjusted.ranked {
+		if err = uc.leaderboard.Update(ctx, adjusted.rating); err != nil {
+			return nil, apperrors.FromDomainError(err)
+		}
+	}
+
+	return &dto.AdjustRatingOutputDTO{
+		Rating:  adjusted.rating,
+		History: adjusted.history,
+	}, nil
+}
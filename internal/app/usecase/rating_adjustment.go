@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/events"
+)
+
+const maxRatingNoteLen = 1000
+
+// ratingChange describes a rating change made by hand.
+type ratingChange struct {
+	reason  enums.ChangeReason
+	gameID  *uuid.UUID
+	actorID int64
+	note    string
+}
+
+// adjustedRating is the outcome of a rating change made by hand.
+type adjustedRating struct {
+	rating  *user.Rating
+	history *user.RatingHistory
+	// ranked is false for users left off the leaderboards, whose rating must not be put on them.
+	ranked bool
+}
+
+// ratingAdjuster applies rating changes made by hand together with their history entry and event. It must be
+// used inside a transaction.
+type ratingAdjuster struct {
+	userRepository   user.Repository
+	ratingRepository user.RatingRepository
+	outbox           events.Outbox
+}
+
+// apply locks the user and the rating and sets the rating to the value returned by newRating. Ratings of deleted
+// users are not changed.
+func (a *ratingAdjuster) apply(
+	ctx context.Context,
+	userID int64,
+	timeControl enums.TimeControl,
+	change *ratingChange,
+	newRating func(r *user.Rating) (int, error),
+) (*adjustedRating, error) {
+	u, err := a.userRepository.LockByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = u.EnsureCanBeRated(); err != nil {
+		return nil, err
+	}
+
+	r, err := a.ratingRepository.LockUserRating(ctx, userID, timeControl)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := newRating(r)
+	if err != nil {
+		return nil, err
+	}
+
+	oldRating := r.Rating
+
+	if err = r.Adjust(value); err != nil {
+		return nil, err
+	}
+
+	if err = a.ratingRepository.UpdateRating(ctx, r); err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, domainerrors.ErrGeneratingRatingHistoryID
+	}
+
+	history := &user.RatingHistory{
+		Id:           id,
+		UserID:       r.UserID,
+		TimeControl:  r.TimeControl,
+		OldRating:    oldRating,
+		NewRating:    r.Rating,
+		RatingRange:  int(math.Round(r.Deviation)),
+		GameID:       change.gameID,
+		ChangeReason: change.reason,
+		ActorID:      &change.actorID,
+		Note:         change.note,
+		CreatedAt:    r.UpdatedAt,
+	}
+
+	if err = a.ratingRepository.CreateRatingHistory(ctx, history); err != nil {
+		return nil, err
+	}
+
+	if err = a.outbox.Append(ctx, events.NewRatingChanged(history)); err != nil {
+		return nil, err
+	}
+
+	return &adjustedRating{
+		rating:  r,
+		history: history,
+		ranked:  u.IsRanked(),
+	}, nil
+}
+
+func validateRatingChange(errs map[string]string, actorID int64, note string) {
+	if actorID <= 0 {
+		errs["actor_id"] = "actor id required"
+	}
+
+	switch {
+	case strings.TrimSpace(note) == "":
+		errs["note"] = "note required"
+	case len(note) > maxRatingNoteLen:
+		errs["note"] = "value is too long"
+	}
+}
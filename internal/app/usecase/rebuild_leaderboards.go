@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+)
+
+const rebuildLeaderboardsBatch = 1000
+
+type (
+	RebuildLeaderboards UseCase[struct{}, *dto.RebuildLeaderboardsOutputDTO]
+
+	rebuildLeaderboards struct {
+		leaderboard      user.Leaderboard
+		ratingRepository user.RatingRepository
+	}
+)
+
+// NewRebuildLeaderboards copies every rating into the leaderboard. Leaderboard updates are versioned, so the
+// rebuild is safe to run while games are being recorded and can be restarted at any time.
+func NewRebuildLeaderboards(leaderboard user.Leaderboard, ratingRepository user.RatingRepository) RebuildLeaderboards {
+	return &rebuildLeaderboards{
+		leaderboard:      leaderboard,
+		ratingRepository: ratingRepository,
+	}
+}
+
+func (uc *rebuildLeaderboards) Execute(ctx context.Context, _ struct{}) (*dto.RebuildLeaderboardsOutputDTO, error) {
+	output := &dto.RebuildLeaderboardsOutputDTO{
+		Rebuilt: make(map[string]int, len(enums.TimeControls)),
+	}
+
+	for _, timeControl := range enums.TimeControls {
+		var afterUserID int64
+
+		for {
+			ratings, err := uc.ratingRepository.ListRatings(ctx, timeControl, afterUserID, rebuildLeaderboardsBatch)
+			if err != nil {
+				return output, err
+			}
+
+			if len(ratings) == 0 {
+				break
+			}
+
+			if err = uc.leaderboard.Update(ctx, ratings...); err != nil {
+				return output, err
+			}
+
+			output.Rebuilt[string(timeControl)] += len(ratings)
+			afterUserID = ratings[len(ratings)-1].UserID
+		}
+	}
+
+	return output, nil
+}
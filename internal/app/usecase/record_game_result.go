@@ -0,0 +1,249 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/events"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/services/rating"
+	"github.com/google/uuid"
+)
+
+type (
+	RecordGameResult UseCase[*dto.RecordGameResultInputDTO, *dto.RecordGameResultOutputDTO]
+
+	recordGameResult struct {
+		transactor           Transactor
+		userRepository       user.Repository
+		ratingRepository     user.RatingRepository
+		headToHeadRepository user.HeadToHeadRepository
+		leaderboard          user.Leaderboard
+		outbox               events.Outbox
+		engine               *rating.Engine
+	}
+)
+
+// gameResultClockSkew is how far in the future a finish time may be, so game servers whose clock runs slightly
+// ahead are not rejected.
+const gameResultClockSkew = time.Minute
+
+func NewRecordGameResult(
+	transactor Transactor,
+	repository user.Repository,
+	ratingRepository user.RatingRepository,
+	headToHeadRepository user.HeadToHeadRepository,
+	leaderboard user.Leaderboard,
+	outbox events.Outbox,
+	engine *rating.Engine,
+) RecordGameResult {
+	return &recordGameResult{
+		transactor:           transactor,
+		userRepository:       repository,
+		ratingRepository:     ratingRepository,
+		headToHeadRepository: headToHeadRepository,
+		leaderboard:          leaderboard,
+		outbox:               outbox,
+		engine:               engine,
+	}
+}
+
+func (uc *recordGameResult) Execute(
+	ctx context.Context,
+	input *dto.RecordGameResultInputDTO,
+) (*dto.RecordGameResultOutputDTO, error) {
+	errs := make(map[string]string)
+
+	gameID, err := uuid.Parse(input.GameID)
+	if err != nil {
+		errs["game_id"] = "game id must be a valid uuid"
+	}
+
+	if input.WhiteUserID == input.BlackUserID {
+		errs["black_user_id"] = "players must be different users"
+	}
+
+	timeControl := enums.TimeControl(input.TimeControl)
+	if !timeControl.IsValid() {
+		errs["time_control"] = "unknown time control"
+	}
+
+	outcome := enums.GameOutcome(input.Outcome)
+	if outcome != enums.GameOutcomeWhiteWins && outcome != enums.GameOutcomeBlackWins && outcome != enums.GameOutcomeDraw {
+		errs["outcome"] = "unknown outcome"
+	}
+
+	finishedAt := time.Now()
+
+	if input.FinishedAt != nil {
+		switch {
+		// An empty protobuf timestamp arrives as the Unix epoch rather than the zero time.
+		case input.FinishedAt.Unix() <= 0:
+			errs["finished_at"] = "finish time required"
+		case input.FinishedAt.After(finishedAt.Add(gameResultClockSkew)):
+			errs["finished_at"] = "finish time must not be in the future"
+		default:
+			finishedAt = *input.FinishedAt
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, apperrors.NewInvalidArgumentError("validation failed", errs)
+	}
+
+	var (
+		output  = &dto.RecordGameResultOutputDTO{}
+		players []*user.User
+	)
+
+	err = uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		// Users are locked before their ratings, as every other rating change does.
+		whiteUser, blackUser, err := uc.lockUsers(ctx, input.WhiteUserID, input.BlackUserID)
+		if err != nil {
+			return err
+		}
+
+		players = []*user.User{whiteUser, blackUser}
+
+		white, black, err := uc.lockRatings(ctx, input.WhiteUserID, input.BlackUserID, timeControl)
+		if err != nil {
+			return err
+		}
+
+		output.White, output.Black = white, black
+
+		// Checked after locking both ratings so a concurrent retry observes the committed first report.
+		recorded, err := uc.ratingRepository.HasGameHistory(ctx, gameID)
+		if err != nil {
+			return err
+		}
+
+		if recorded {
+			output.AlreadyRecorded = true
+
+			return nil
+		}
+
+		// Checked after the history so a retry of a game recorded before one of the players was banned still
+		// succeeds.
+		for _, u := range players {
+			if err = u.EnsureCanPlay(); err != nil {
+				return err
+			}
+		}
+
+		whiteHistory, blackHistory, err := uc.engine.Apply(&rating.GameResult{
+			GameID:   gameID,
+			White:    white,
+			Black:    black,
+			Outcome:  outcome,
+			PlayedAt: finishedAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, r := range []*user.Rating{white, black} {
+			if err = uc.ratingRepository.UpdateRating(ctx, r); err != nil {
+				return err
+			}
+		}
+
+		for _, h := range []*user.RatingHistory{whiteHistory, blackHistory} {
+			if err = uc.ratingRepository.CreateRatingHistory(ctx, h); err != nil {
+				return err
+			}
+		}
+
+		whiteRecord, blackRecord, err := user.HeadToHeadOfGame(
+			input.WhiteUserID,
+			input.BlackUserID,
+			timeControl,
+			outcome,
+			finishedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		if err = uc.headToHeadRepository.Add(ctx, whiteRecord, blackRecord); err != nil {
+			return err
+		}
+
+		return uc.outbox.Append(ctx, events.NewRatingChanged(whiteHistory), events.NewRatingChanged(blackHistory))
+	})
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	// Also done for games recorded before: a retry after a failed leaderboard update brings it back in sync. A
+	// player banned or deleted since then stays off the leaderboard.
+	ranked := make([]*user.Rating, 0, len(players))
+
+	for i, r := range []*user.Rating{output.White, output.Black} {
+		if players[i].IsRanked() {
+			ranked = append(ranked, r)
+		}
+	}
+
+	if err = uc.leaderboard.Update(ctx, ranked...); err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	return output, nil
+}
+
+// lockUsers locks the lower user id first, like lockRatings.
+func (uc *recordGameResult) lockUsers(ctx context.Context, whiteUserID, blackUserID int64) (*user.User, *user.User, error) {
+	firstID, secondID := whiteUserID, blackUserID
+	if firstID > secondID {
+		firstID, secondID = secondID, firstID
+	}
+
+	first, err := uc.userRepository.LockByID(ctx, firstID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	second, err := uc.userRepository.LockByID(ctx, secondID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if first.ID() == whiteUserID {
+		return first, second, nil
+	}
+
+	return second, first, nil
+}
+
+// lockRatings always locks the lower user id first so two games between the same players cannot deadlock.
+func (uc *recordGameResult) lockRatings(
+	ctx context.Context,
+	whiteUserID, blackUserID int64,
+	timeControl enums.TimeControl,
+) (*user.Rating, *user.Rating, error) {
+	firstID, secondID := whiteUserID, blackUserID
+	if firstID > secondID {
+		firstID, secondID = secondID, firstID
+	}
+
+	first, err := uc.ratingRepository.LockUserRating(ctx, firstID, timeControl)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	second, err := uc.ratingRepository.LockUserRating(ctx, secondID, timeControl)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if first.UserID == whiteUserID {
+		return first, second, nil
+	}
+
+	return second, first, nil
+}
@@ -0,0 +1,191 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/events"
+)
+
+const maxRefundGames = 100
+
+// errNothingToRefund rolls back the refund of a game that has nothing to give back.
+var errNothingToRefund = errors.New("nothing to refund")
+
+type (
+	RefundOpponents UseCase[*dto.RefundOpponentsInputDTO, *dto.RefundOpponentsOutputDTO]
+
+	refundOpponents struct {
+		transactor       Transactor
+		ratingRepository user.RatingRepository
+		adjuster         *ratingAdjuster
+		leaderboard      user.Leaderboard
+	}
+)
+
+// NewRefundOpponents gives the opponents of a cheater back the rating they lost in the listed games. Each game is
+// refunded in its own transaction and at most once, so a failed request can be retried with the same games.
+// Games of opponents that have been deleted are skipped.
+func NewRefundOpponents(
+	transactor Transactor,
+	repository user.Repository,
+	ratingRepository user.RatingRepository,
+	leaderboard user.Leaderboard,
+	outbox events.Outbox,
+) RefundOpponents {
+	return &refundOpponents{
+		transactor:       transactor,
+		ratingRepository: ratingRepository,
+		adjuster: &ratingAdjuster{
+			userRepository:   repository,
+			ratingRepository: ratingRepository,
+			outbox:           outbox,
+		},
+		leaderboard: leaderboard,
+	}
+}
+
+func (uc *refundOpponents) Execute(
+	ctx context.Context,
+	input *dto.RefundOpponentsInputDTO,
+) (*dto.RefundOpponentsOutputDTO, error) {
+	errs := make(map[string]string)
+
+	if input.CheaterID <= 0 {
+		errs["cheater_id"] = "cheater id required"
+	}
+
+	gameIDs := make([]uuid.UUID, 0, len(input.GameIDs))
+
+	for _, raw := range input.GameIDs {
+		gameID, err := uuid.Parse(raw)
+		if err != nil {
+			errs["game_ids"] = "game ids must be valid uuids"
+
+			break
+		}
+
+		gameIDs = append(gameIDs, gameID)
+	}
+
+	if len(input.GameIDs) == 0 || len(input.GameIDs) > maxRefundGames {
+		errs["game_ids"] = "between 1 and 100 game ids required"
+	}
+
+	validateRatingChange(errs, input.ActorID, input.Note)
+
+	if len(errs) > 0 {
+		return nil, apperrors.NewInvalidArgumentError("validation failed", errs)
+	}
+
+	output := &dto.RefundOpponentsOutputDTO{
+		Refunds:        []*user.RatingHistory{},
+		SkippedGameIDs: []string{},
+	}
+
+	for _, gameID := range gameIDs {
+		adjusted, err := uc.refund(ctx, input, gameID)
+		if errors.Is(err, errNothingToRefund) || errors.Is(err, domainerrors.ErrUserDeleted) {
+			output.SkippedGameIDs = append(output.SkippedGameIDs, gameID.String())
+
+			continue
+		}
+
+		if err != nil {
+			return nil, apperrors.FromDomainError(err)
+		}
+
+		if adjusted.ranked {
+			if err = uc.leaderboard.Update(ctx, adjusted.rating); err != nil {
+				return nil, apperrors.FromDomainError(err)
+			}
+		}
+
+		output.Refunds = append(output.Refunds, adjusted.history)
+	}
+
+	return output, nil
+}
+
+func (uc *refundOpponents) refund(
+	ctx context.Context,
+	input *dto.RefundOpponentsInputDTO,
+	gameID uuid.UUID,
+) (*adjustedRating, error) {
+	var adjusted *adjustedRating
+
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		opponentGame, err := uc.opponentGame(ctx, input.CheaterID, gameID)
+		if err != nil {
+			return err
+		}
+
+		lost := opponentGame.OldRating - opponentGame.NewRating
+		if lost <= 0 {
+			return errNothingToRefund
+		}
+
+		change := &ratingChange{
+			reason:  enums.ChangeReasonRefund,
+			gameID:  &gameID,
+			actorID: input.ActorID,
+			note:    input.Note,
+		}
+
+		adjusted, err = uc.adjuster.apply(ctx, opponentGame.UserID, opponentGame.TimeControl, change,
+			func(r *user.Rating) (int, error) {
+				// Read again under the lock of the rating, a concurrent refund of the game has committed by now.
+				entries, err := uc.ratingRepository.GetGameHistory(ctx, gameID)
+				if err != nil {
+					return 0, err
+				}
+
+				for _, h := range entries {
+					if h.UserID == r.UserID && h.ChangeReason == enums.ChangeReasonRefund {
+						return 0, errNothingToRefund
+					}
+				}
+
+				return min(r.Rating+lost, user.MaxRating), nil
+			})
+
+		return err
+	})
+
+	return adjusted, err
+}
+
+// opponentGame returns the history entry of the game for the opponent of the cheater.
+func (uc *refundOpponents) opponentGame(ctx context.Context, cheaterID int64, gameID uuid.UUID) (*user.RatingHistory, error) {
+	entries, err := uc.ratingRepository.GetGameHistory(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	var cheater, opponent *user.RatingHistory
+
+	for _, h := range entries {
+		if h.ChangeReason != enums.ChangeReasonGame {
+			continue
+		}
+
+		if h.UserID == cheaterID {
+			cheater = h
+		} else {
+			opponent = h
+		}
+	}
+
+	if cheater == nil || opponent == nil {
+		return nil, errNothingToRefund
+	}
+
+	return opponent, nil
+}
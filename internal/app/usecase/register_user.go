@@ -6,28 +6,41 @@ import (
 	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
 	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
 	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
-	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/interfaces"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/events"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/services/namepolicy"
 	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/email"
 	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/password"
 	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/publicname"
-	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/tag"
 )
 
 type (
 	RegisterUser UseCase[*dto.RegisterUserInputDTO, *dto.RegisterUserOutputDTO]
 
 	registerUser struct {
-		userRepository user.Repository
-		hasher         password.Hasher
-		userService    user.Service
+		transactor            Transactor
+		hasher                password.Hasher
+		userService           user.Service
+		outbox                events.Outbox
+		sendEmailVerification SendEmailVerification
+		namePolicy            *namepolicy.Policy
 	}
 )
 
-func NewRegisterUser(repository user.Repository, hasher password.Hasher, service user.Service) RegisterUser {
+func NewRegisterUser(
+	transactor Transactor,
+	hasher password.Hasher,
+	service user.Service,
+	outbox events.Outbox,
+	sendEmailVerification SendEmailVerification,
+	namePolicy *namepolicy.Policy,
+) RegisterUser {
 	return &registerUser{
-		userRepository: repository,
-		hasher:         hasher,
-		userService:    service,
+		transactor:            transactor,
+		hasher:                hasher,
+		userService:           service,
+		outbox:                outbox,
+		sendEmailVerification: sendEmailVerification,
+		namePolicy:            namePolicy,
 	}
 }
 
@@ -51,6 +64,8 @@ func (uc *registerUser) Execute(ctx context.Context, input *dto.RegisterUserInpu
 	publicNameVO, err := publicname.New(input.PublicName)
 	if err != nil {
 		errs["publicname"] = err.Error()
+	} else if err = uc.namePolicy.Check(publicNameVO.Value()); err != nil {
+		errs["publicname"] = err.Error()
 	}
 
 	if len(errs) > 0 {
@@ -59,12 +74,16 @@ func (uc *registerUser) Execute(ctx context.Context, input *dto.RegisterUserInpu
 
 	hashed, err := plainPasswordVO.Hash(uc.hasher)
 	if err != nil {
-		errs["password"] = err.Error()
+		return nil, apperrors.NewInternalError("Failed to secure password.").WithCause(err)
 	}
 
-	builder := user.NewBuilder()
+	tagVO, err := uc.userService.BuildUserTag(ctx, 0, nil)
+	if err != nil {
+		return nil, apperrors.NewInternalError("Failed to generate user tag.").WithCause(err)
+	}
 
-	u := builder.
+	u := user.NewBuilder().
+		WithTag(tagVO).
 		WithEmail(emailVO).
 		WithPassword(hashed).
 		WithLanguage(input.Language).
@@ -74,18 +93,34 @@ func (uc *registerUser) Execute(ctx context.Context, input *dto.RegisterUserInpu
 		PublicName: publicNameVO,
 	}
 
-	u.Initialize()
+	if input.CountryCode != "" {
+		profile.CountryCode = &input.CountryCode
+	}
 
-	_, err = uc.userRepo.Create(ctx, u)
+	err = uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		u, _, err = uc.userService.CreateUser(ctx, u, profile)
+		if err != nil {
+			return err
+		}
+
+		return uc.outbox.Append(ctx, &events.UserRegistered{
+			UserID:       u.ID(),
+			Tag:          u.Tag().Value(),
+			Email:        u.Email().Value(),
+			Language:     u.Language(),
+			RegisteredAt: u.CreatedAt(),
+		})
+	})
 	if err != nil {
 		return nil, apperrors.FromDomainError(err)
 	}
 
+	// The account already exists at this point, a lost email can be requested again.
+	_, _ = uc.sendEmailVerification.Execute(ctx, &dto.SendEmailVerificationInputDTO{
+		UserID: u.ID(),
+	})
+
 	return &dto.RegisterUserOutputDTO{
 		Message: "User successfully registered.",
 	}, nil
 }
-
-func (uc *registerUser) buildUser(input *dto.RegisterUserInputDTO) (*user.User, error) {
-
-}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/events"
+)
+
+type (
+	ReinstateUser UseCase[*dto.ReinstateUserInputDTO, *dto.ModerateUserOutputDTO]
+
+	reinstateUser struct {
+		transactor       Transactor
+		ratingRepository user.RatingRepository
+		leaderboard      user.Leaderboard
+		moderator        *moderator
+	}
+)
+
+func NewReinstateUser(
+	transactor Transactor,
+	repository user.Repository,
+	moderationRepository user.ModerationRepository,
+	ratingRepository user.RatingRepository,
+	leaderboard user.Leaderboard,
+	outbox events.Outbox,
+) ReinstateUser {
+	return &reinstateUser{
+		transactor:       transactor,
+		ratingRepository: ratingRepository,
+		leaderboard:      leaderboard,
+		moderator: &moderator{
+			userRepository:       repository,
+			moderationRepository: moderationRepository,
+			outbox:               outbox,
+		},
+	}
+}
+
+func (uc *reinstateUser) Execute(ctx context.Context, input *dto.ReinstateUserInputDTO) (*dto.ModerateUserOutputDTO, error) {
+	errs := make(map[string]string)
+
+	validateModeration(errs, input.ActorID, input.Reason)
+
+	if len(errs) > 0 {
+		return nil, apperrors.NewInvalidArgumentError("validation failed", errs)
+	}
+
+	var u *user.User
+
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		record := &user.ModerationRecord{
+			Action:  enums.ModerationActionReinstate,
+			ActorID: &input.ActorID,
+			Reason:  input.Reason,
+		}
+
+		var err error
+
+		u, err = uc.moderator.apply(ctx, input.UserID, record, func(u *user.User) error {
+			return u.Reinstate()
+		})
+
+		return err
+	})
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	// A ban took the player off the leaderboard, their ratings are put back once the reinstatement is committed.
+	ratings, err := uc.ratingRepository.GetUserRatings(ctx, u.ID())
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	if err = uc.leaderboard.Update(ctx, ratings...); err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	return &dto.ModerateUserOutputDTO{
+		User: u,
+	}, nil
+}
@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/mail"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/ratelimit"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/email"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/pkg/tokengen"
+)
+
+const (
+	passwordResetTokenLen = 32
+
+	passwordResetRequestedMessage = "If the account exists, a password reset email has been sent."
+)
+
+type (
+	RequestPasswordReset UseCase[*dto.RequestPasswordResetInputDTO, *dto.RequestPasswordResetOutputDTO]
+
+	requestPasswordReset struct {
+		userRepository  user.Repository
+		tokenRepository user.TokenRepository
+		mailSender      mail.Sender
+		limiter         ratelimit.Limiter
+		tokenTTL        time.Duration
+		resetURL        string
+	}
+)
+
+func NewRequestPasswordReset(
+	repository user.Repository,
+	tokenRepository user.TokenRepository,
+	mailSender mail.Sender,
+	limiter ratelimit.Limiter,
+	tokenTTL time.Duration,
+	resetURL string,
+) RequestPasswordReset {
+	return &requestPasswordReset{
+		userRepository:  repository,
+		tokenRepository: tokenRepository,
+		mailSender:      mailSender,
+		limiter:         limiter,
+		tokenTTL:        tokenTTL,
+		resetURL:        resetURL,
+	}
+}
+
+// Execute answers identically whether the email is registered or not, so it cannot be used to probe accounts.
+func (uc *requestPasswordReset) Execute(
+	ctx context.Context,
+	input *dto.RequestPasswordResetInputDTO,
+) (*dto.RequestPasswordResetOutputDTO, error) {
+	emailVO, err := email.New(input.Email)
+	if err != nil {
+		return nil, apperrors.NewInvalidArgumentError("validation failed", map[string]string{
+			"email": err.Error(),
+		})
+	}
+
+	allowed, err := uc.limiter.Allow(ctx, strings.ToLower(emailVO.Value()))
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	if !allowed {
+		return nil, apperrors.NewResourceExhaustedError("Too many password reset requests. Try again later.")
+	}
+
+	output := &dto.RequestPasswordResetOutputDTO{
+		Message: passwordResetRequestedMessage,
+	}
+
+	u, err := uc.userRepository.GetByEmail(ctx, emailVO.Value())
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrUserNotFound) {
+			return output, nil
+		}
+
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	if u.EnsureCanLogin() != nil {
+		return output, nil
+	}
+
+	token, err := tokengen.Generate(passwordResetTokenLen)
+	if err != nil {
+		return nil, apperrors.NewInternalError("Failed to generate password reset token.").WithCause(err)
+	}
+
+	if err = uc.tokenRepository.Save(ctx, token, u.ID(), uc.tokenTTL); err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	err = uc.mailSender.Send(ctx, &mail.Message{
+		To:      u.Email().Value(),
+		Subject: "Reset your ChessHub password",
+		Body: fmt.Sprintf(
+			"Follow the link to choose a new password: %s?token=%s\nThe link expires in %s. "+
+				"If you did not request a reset, ignore this email.",
+			uc.resetURL, url.QueryEscape(token), uc.tokenTTL,
+		),
+	})
+	if err != nil {
+		return nil, apperrors.NewInternalError("Failed to send password reset email.").WithCause(err)
+	}
+
+	return output, nil
+}
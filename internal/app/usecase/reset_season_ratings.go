@@ -0,0 +1,191 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/events"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/services/rating"
+)
+
+const defaultSeasonResetBatch = 500
+
+type (
+	ResetSeasonRatings UseCase[struct{}, *dto.ResetSeasonRatingsOutputDTO]
+
+	resetSeasonRatings struct {
+		transactor       Transactor
+		seasonRepository user.SeasonRepository
+		ratingRepository user.RatingRepository
+		leaderboard      user.Leaderboard
+		outbox           events.Outbox
+		softReset        *rating.SoftReset
+		batchSize        int
+	}
+)
+
+// NewResetSeasonRatings applies the soft reset queued by ended seasons. Every chunk of ratings is reset in one
+// transaction together with the progress of the reset, so the job can be stopped and restarted at any time.
+func NewResetSeasonRatings(
+	transactor Transactor,
+	seasonRepository user.SeasonRepository,
+	ratingRepository user.RatingRepository,
+	leaderboard user.Leaderboard,
+	outbox events.Outbox,
+	softReset *rating.SoftReset,
+	batchSize int,
+) ResetSeasonRatings {
+	if batchSize <= 0 {
+		batchSize = defaultSeasonResetBatch
+	}
+
+	return &resetSeasonRatings{
+		transactor:       transactor,
+		seasonRepository: seasonRepository,
+		ratingRepository: ratingRepository,
+		leaderboard:      leaderboard,
+		outbox:           outbox,
+		softReset:        softReset,
+		batchSize:        batchSize,
+	}
+}
+
+func (uc *resetSeasonRatings) Execute(ctx context.Context, _ struct{}) (*dto.ResetSeasonRatingsOutputDTO, error) {
+	output := &dto.ResetSeasonRatingsOutputDTO{
+		Reset: make(map[string]int, len(enums.TimeControls)),
+	}
+
+	pending, err := uc.seasonRepository.ListPendingResets(ctx)
+	if err != nil {
+		return output, err
+	}
+
+	for _, p := range pending {
+		for {
+			ratings, done, err := uc.resetChunk(ctx, p.SeasonID, p.TimeControl)
+			if err != nil {
+				return output, err
+			}
+
+			output.Reset[string(p.TimeControl)] += len(ratings)
+
+			if err = uc.leaderboard.Update(ctx, ratings...); err != nil {
+				return output, err
+			}
+
+			if done {
+				break
+			}
+		}
+	}
+
+	return output, nil
+}
+
+// resetChunk resets the ratings following the last one done and reports whether the reset is complete.
+func (uc *resetSeasonRatings) resetChunk(
+	ctx context.Context,
+	seasonID int64,
+	timeControl enums.TimeControl,
+) ([]*user.Rating, bool, error) {
+	var (
+		reset *user.SeasonReset
+		done  []*user.Rating
+	)
+
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+
+		done = nil
+
+		reset, err = uc.seasonRepository.LockReset(ctx, seasonID, timeControl)
+		if err != nil {
+			return err
+		}
+
+		// Another worker finished it since it was listed.
+		if reset.CompletedAt != nil {
+			return nil
+		}
+
+		// Deleted and banned users are not listed, so they are not put back on the leaderboard of the new season.
+		ratings, err := uc.ratingRepository.ListRatings(ctx, timeControl, reset.LastUserID, uc.batchSize)
+		if err != nil {
+			return err
+		}
+
+		var lastUserID int64
+
+		for _, r := range ratings {
+			lastUserID = r.UserID
+
+			// Players without games sit on the default rating and were left out of the standings.
+			if r.GamesPlayed == 0 {
+				continue
+			}
+
+			r, err = uc.resetRating(ctx, reset, r.UserID)
+			if err != nil {
+				return err
+			}
+
+			done = append(done, r)
+		}
+
+		reset.Advance(lastUserID, len(ratings) < uc.batchSize, time.Now())
+
+		return uc.seasonRepository.UpdateReset(ctx, reset)
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return done, reset.CompletedAt != nil, nil
+}
+
+func (uc *resetSeasonRatings) resetRating(ctx context.Context, reset *user.SeasonReset, userID int64) (*user.Rating, error) {
+	r, err := uc.ratingRepository.LockUserRating(ctx, userID, reset.TimeControl)
+	if err != nil {
+		return nil, err
+	}
+
+	oldRating := r.Rating
+
+	if err = uc.softReset.Apply(r, reset.MeanRating); err != nil {
+		return nil, err
+	}
+
+	if err = uc.ratingRepository.UpdateRating(ctx, r); err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, domainerrors.ErrGeneratingRatingHistoryID
+	}
+
+	history := &user.RatingHistory{
+		Id:           id,
+		UserID:       r.UserID,
+		TimeControl:  r.TimeControl,
+		OldRating:    oldRating,
+		NewRating:    r.Rating,
+		RatingRange:  int(math.Round(r.Deviation)),
+		ChangeReason: enums.ChangeReasonSeasonReset,
+		Note:         "end of season " + reset.SeasonName,
+		CreatedAt:    r.UpdatedAt,
+	}
+
+	if err = uc.ratingRepository.CreateRatingHistory(ctx, history); err != nil {
+		return nil, err
+	}
+
+	return r, uc.outbox.Append(ctx, events.NewRatingChanged(history))
+}
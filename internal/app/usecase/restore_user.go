@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/events"
+)
+
+const accountRestoredReason = "account restored"
+
+type (
+	RestoreUser UseCase[*dto.RestoreUserInputDTO, *dto.RestoreUserOutputDTO]
+
+	restoreUser struct {
+		transactor       Transactor
+		userRepository   user.Repository
+		ratingRepository user.RatingRepository
+		leaderboard      user.Leaderboard
+		outbox           events.Outbox
+		gracePeriod      time.Duration
+	}
+)
+
+func NewRestoreUser(
+	transactor Transactor,
+	repository user.Repository,
+	ratingRepository user.RatingRepository,
+	leaderboard user.Leaderboard,
+	outbox events.Outbox,
+	gracePeriod time.Duration,
+) RestoreUser {
+	return &restoreUser{
+		transactor:       transactor,
+		userRepository:   repository,
+		ratingRepository: ratingRepository,
+		leaderboard:      leaderboard,
+		outbox:           outbox,
+		gracePeriod:      gracePeriod,
+	}
+}
+
+func (uc *restoreUser) Execute(ctx context.Context, input *dto.RestoreUserInputDTO) (*dto.RestoreUserOutputDTO, error) {
+	var u *user.User
+
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+
+		u, err = uc.userRepository.LockByID(ctx, input.UserID)
+		if err != nil {
+			return err
+		}
+
+		oldStatus := u.Status()
+
+		if err = u.Restore(uc.gracePeriod); err != nil {
+			return err
+		}
+
+		u, err = uc.userRepository.Update(ctx, u)
+		if err != nil {
+			return err
+		}
+
+		return uc.outbox.Append(ctx, &events.UserStatusChanged{
+			UserID:    u.ID(),
+			OldStatus: oldStatus,
+			NewStatus: u.Status(),
+			Reason:    accountRestoredReason,
+			ChangedAt: u.UpdatedAt(),
+		})
+	})
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	ratings, err := uc.ratingRepository.GetUserRatings(ctx, u.ID())
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	if err = uc.leaderboard.Update(ctx, ratings...); err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	return &dto.RestoreUserOutputDTO{
+		User: u,
+	}, nil
+}
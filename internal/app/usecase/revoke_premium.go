@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/events"
+)
+
+type (
+	RevokePremium UseCase[*dto.RevokePremiumInputDTO, *dto.PremiumOutputDTO]
+
+	revokePremium struct {
+		transactor     Transactor
+		userRepository user.Repository
+		outbox         events.Outbox
+	}
+)
+
+func NewRevokePremium(transactor Transactor, repository user.Repository, outbox events.Outbox) RevokePremium {
+	return &revokePremium{
+		transactor:     transactor,
+		userRepository: repository,
+		outbox:         outbox,
+	}
+}
+
+func (uc *revokePremium) Execute(ctx context.Context, input *dto.RevokePremiumInputDTO) (*dto.PremiumOutputDTO, error) {
+	var u *user.User
+
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+
+		u, err = uc.userRepository.LockByID(ctx, input.UserID)
+		if err != nil {
+			return err
+		}
+
+		if err = u.RevokePremium(); err != nil {
+			return err
+		}
+
+		u, err = uc.userRepository.Update(ctx, u)
+		if err != nil {
+			return err
+		}
+
+		return uc.outbox.Append(ctx, &events.PremiumChanged{
+			UserID:    u.ID(),
+			Change:    events.PremiumRevoked,
+			IsPremium: false,
+			ChangedAt: u.UpdatedAt(),
+		})
+	})
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	return &dto.PremiumOutputDTO{
+		User: u,
+	}, nil
+}
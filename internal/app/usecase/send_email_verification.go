@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/mail"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/pkg/tokengen"
+)
+
+const verificationTokenLen = 32
+
+type (
+	SendEmailVerification UseCase[*dto.SendEmailVerificationInputDTO, *dto.SendEmailVerificationOutputDTO]
+
+	sendEmailVerification struct {
+		userRepository  user.Repository
+		tokenRepository user.TokenRepository
+		mailSender      mail.Sender
+		tokenTTL        time.Duration
+		confirmURL      string
+	}
+)
+
+func NewSendEmailVerification(
+	repository user.Repository,
+	tokenRepository user.TokenRepository,
+	mailSender mail.Sender,
+	tokenTTL time.Duration,
+	confirmURL string,
+) SendEmailVerification {
+	return &sendEmailVerification{
+		userRepository:  repository,
+		tokenRepository: tokenRepository,
+		mailSender:      mailSender,
+		tokenTTL:        tokenTTL,
+		confirmURL:      confirmURL,
+	}
+}
+
+func (uc *sendEmailVerification) Execute(
+	ctx context.Context,
+	input *dto.SendEmailVerificationInputDTO,
+) (*dto.SendEmailVerificationOutputDTO, error) {
+	u, err := uc.userRepository.GetByID(ctx, input.UserID)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	if u.IsVerified() {
+		return nil, apperrors.FromDomainError(domainerrors.ErrEmailAlreadyVerified)
+	}
+
+	token, err := tokengen.Generate(verificationTokenLen)
+	if err != nil {
+		return nil, apperrors.NewInternalError("Failed to generate verification token.").WithCause(err)
+	}
+
+	// Only the most recently sent link stays valid.
+	if err = uc.tokenRepository.RevokeAll(ctx, u.ID()); err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	if err = uc.tokenRepository.Save(ctx, token, u.ID(), uc.tokenTTL); err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	err = uc.mailSender.Send(ctx, &mail.Message{
+		To:      u.Email().Value(),
+		Subject: "Confirm your ChessHub email",
+		Body: fmt.Sprintf(
+			"Follow the link to confirm your email: %s?token=%s\nThe link expires in %s.",
+			uc.confirmURL, url.QueryEscape(token), uc.tokenTTL,
+		),
+	})
+	if err != nil {
+		return nil, apperrors.NewInternalError("Failed to send verification email.").WithCause(err)
+	}
+
+	return &dto.SendEmailVerificationOutputDTO{
+		Message: "Verification email sent.",
+	}, nil
+}
@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+)
+
+const maxSeasonNameLen = 64
+
+type (
+	StartSeason UseCase[*dto.StartSeasonInputDTO, *dto.StartSeasonOutputDTO]
+
+	startSeason struct {
+		transactor       Transactor
+		seasonRepository user.SeasonRepository
+	}
+)
+
+// NewStartSeason ends the season in progress and starts the next one. The final standings are archived in the
+// same transaction and the soft reset of the ratings is left to ResetSeasonRatings.
+func NewStartSeason(transactor Transactor, seasonRepository user.SeasonRepository) StartSeason {
+	return &startSeason{
+		transactor:       transactor,
+		seasonRepository: seasonRepository,
+	}
+}
+
+func (uc *startSeason) Execute(ctx context.Context, input *dto.StartSeasonInputDTO) (*dto.StartSeasonOutputDTO, error) {
+	errs := make(map[string]string)
+
+	name := strings.TrimSpace(input.Name)
+
+	switch {
+	case name == "":
+		errs["name"] = "name required"
+	case len(name) > maxSeasonNameLen:
+		errs["name"] = "value is too long"
+	}
+
+	if len(errs) > 0 {
+		return nil, apperrors.NewInvalidArgumentError("validation failed", errs)
+	}
+
+	output := &dto.StartSeasonOutputDTO{}
+
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		now := time.Now()
+
+		current, err := uc.seasonRepository.LockCurrent(ctx)
+
+		switch {
+		case errors.Is(err, domainerrors.ErrSeasonNotFound):
+		case err != nil:
+			return err
+		default:
+			if err = uc.end(ctx, current, now); err != nil {
+				return err
+			}
+
+			output.Ended = current
+		}
+
+		next := &user.Season{Name: name, StartedAt: now}
+		if err = uc.seasonRepository.Create(ctx, next); err != nil {
+			return err
+		}
+
+		output.Season = next
+
+		return nil
+	})
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	return output, nil
+}
+
+// end archives the standings of the season and queues the reset of every time control. Seasons cannot end
+// while the ratings of the previous one are still being reset, the archive would catch them half way.
+func (uc *startSeason) end(ctx context.Context, season *user.Season, now time.Time) error {
+	pending, err := uc.seasonRepository.ListPendingResets(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(pending) > 0 {
+		return domainerrors.ErrSeasonResetPending
+	}
+
+	if err = season.End(now); err != nil {
+		return err
+	}
+
+	if err = uc.seasonRepository.Update(ctx, season); err != nil {
+		return err
+	}
+
+	for _, timeControl := range enums.TimeControls {
+		players, mean, err := uc.seasonRepository.ArchiveStandings(ctx, season.ID, timeControl)
+		if err != nil {
+			return err
+		}
+
+		reset := &user.SeasonReset{
+			SeasonID:    season.ID,
+			SeasonName:  season.Name,
+			TimeControl: timeControl,
+			MeanRating:  mean,
+		}
+
+		// Nobody played, there is nothing to reset.
+		if players == 0 {
+			reset.Advance(0, true, now)
+		}
+
+		if err = uc.seasonRepository.CreateReset(ctx, reset); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/events"
+)
+
+type (
+	SuspendUser UseCase[*dto.SuspendUserInputDTO, *dto.ModerateUserOutputDTO]
+
+	suspendUser struct {
+		transactor Transactor
+		moderator  *moderator
+	}
+)
+
+func NewSuspendUser(
+	transactor Transactor,
+	repository user.Repository,
+	moderationRepository user.ModerationRepository,
+	outbox events.Outbox,
+) SuspendUser {
+	return &suspendUser{
+		transactor: transactor,
+		moderator: &moderator{
+			userRepository:       repository,
+			moderationRepository: moderationRepository,
+			outbox:               outbox,
+		},
+	}
+}
+
+func (uc *suspendUser) Execute(ctx context.Context, input *dto.SuspendUserInputDTO) (*dto.ModerateUserOutputDTO, error) {
+	errs := make(map[string]string)
+
+	validateModeration(errs, input.ActorID, input.Reason)
+
+	until := time.Now().Add(input.Duration)
+	if input.Until != nil {
+		until = *input.Until
+	}
+
+	if !until.After(time.Now()) {
+		errs["until"] = "suspension must end in the future"
+	}
+
+	if len(errs) > 0 {
+		return nil, apperrors.NewInvalidArgumentError("validation failed", errs)
+	}
+
+	var u *user.User
+
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		record := &user.ModerationRecord{
+			Action:  enums.ModerationActionSuspend,
+			ActorID: &input.ActorID,
+			Reason:  input.Reason,
+		}
+
+		var err error
+
+		u, err = uc.moderator.apply(ctx, input.UserID, record, func(u *user.User) error {
+			return u.Suspend(input.Reason, until)
+		})
+
+		return err
+	})
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	return &dto.ModerateUserOutputDTO{
+		User: u,
+	}, nil
+}
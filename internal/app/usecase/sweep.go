@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+)
+
+// errSweepSkipped rolls back the change of a user that no longer qualifies once it is locked.
+var errSweepSkipped = errors.New("user no longer qualifies")
+
+// userSweep changes the users found by a query in batches. Every user is changed in its own transaction after
+// being locked, so the change can check the user again and skip one that was changed since it was found. Users
+// are paged through by id: one that is skipped or fails is not found again in the same run, a failure is logged
+// and retried on the next run so it does not hold back the others.
+type userSweep struct {
+	transactor     Transactor
+	userRepository user.Repository
+	batchSize      uint64
+	logger         logrus.FieldLogger
+	// failure is the message users that could not be changed are logged with.
+	failure string
+}
+
+// run changes the users matched by the criteria built for the current time. change gets the locked user and
+// returns errSweepSkipped when it no longer qualifies. It returns the number of changed and failed users.
+func (s *userSweep) run(
+	ctx context.Context,
+	criteria func(now time.Time) *user.Criteria,
+	change func(ctx context.Context, u *user.User, now time.Time) error,
+) (int, int, error) {
+	var (
+		afterID int64
+		changed int
+		failed  int
+	)
+
+	for {
+		now := time.Now()
+
+		query := criteria(now)
+		query.IDAfter = &afterID
+		query.Limit = s.batchSize
+
+		users, err := s.userRepository.Find(ctx, query)
+		if err != nil {
+			return changed, failed, err
+		}
+
+		for _, u := range users {
+			err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+				locked, err := s.userRepository.LockByID(ctx, u.ID())
+				if err != nil {
+					return err
+				}
+
+				return change(ctx, locked, now)
+			})
+
+			switch {
+			case ctx.Err() != nil:
+				return changed, failed, ctx.Err()
+			case errors.Is(err, errSweepSkipped):
+			case err != nil:
+				s.logger.WithError(err).WithField("user_id", u.ID()).Error(s.failure)
+				failed++
+			default:
+				changed++
+			}
+		}
+
+		if uint64(len(users)) < s.batchSize {
+			return changed, failed, nil
+		}
+
+		afterID = users[len(users)-1].ID()
+	}
+}
@@ -0,0 +1,199 @@
+package usecase
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/events"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/services/namepolicy"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/publicname"
+)
+
+const (
+	maxBioLen            = 500
+	maxCityLen           = 128
+	maxTwitchUsernameLen = 64
+)
+
+var countryCodePattern = regexp.MustCompile("^[A-Z]{2}$")
+
+type (
+	UpdateProfile UseCase[*dto.UpdateProfileInputDTO, *dto.UpdateProfileOutputDTO]
+
+	updateProfile struct {
+		transactor        Transactor
+		profileRepository user.ProfileRepository
+		outbox            events.Outbox
+		namePolicy        *namepolicy.Policy
+	}
+)
+
+func NewUpdateProfile(
+	transactor Transactor,
+	profileRepository user.ProfileRepository,
+	outbox events.Outbox,
+	namePolicy *namepolicy.Policy,
+) UpdateProfile {
+	return &updateProfile{
+		transactor:        transactor,
+		profileRepository: profileRepository,
+		outbox:            outbox,
+		namePolicy:        namePolicy,
+	}
+}
+
+func (uc *updateProfile) Execute(ctx context.Context, input *dto.UpdateProfileInputDTO) (*dto.UpdateProfileOutputDTO, error) {
+	errs := make(map[string]string)
+
+	var publicNameVO *publicname.PublicName
+
+	if input.PublicName != nil {
+		var err error
+		if publicNameVO, err = publicname.New(*input.PublicName); err != nil {
+			errs["public_name"] = err.Error()
+		} else if err = uc.namePolicy.Check(publicNameVO.Value()); err != nil {
+			errs["public_name"] = err.Error()
+		}
+	}
+
+	validateLen(errs, "bio", input.Bio, maxBioLen)
+	validateLen(errs, "city", input.City, maxCityLen)
+	validateLen(errs, "twitch_username", input.TwitchUsername, maxTwitchUsernameLen)
+
+	if input.CountryCode != nil && *input.CountryCode != "" && !countryCodePattern.MatchString(*input.CountryCode) {
+		errs["country_code"] = "country code must be an ISO 3166-1 alpha-2 code"
+	}
+
+	if input.BirthDate != nil && input.BirthDate.After(time.Now()) {
+		errs["birth_date"] = "birth date must be in the past"
+	}
+
+	validateURL(errs, "avatar_url", input.AvatarURL)
+	validateURL(errs, "cover_image_url", input.CoverImageURL)
+	validateURL(errs, "website_url", input.WebsiteURL)
+	validateURL(errs, "youtube_channel_url", input.YoutubeChannelURL)
+
+	if len(errs) > 0 {
+		return nil, apperrors.NewInvalidArgumentError("validation failed", errs)
+	}
+
+	var profile *user.Profile
+
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		p, err := uc.profileRepository.GetByUserID(ctx, input.UserID)
+		if err != nil {
+			return err
+		}
+
+		changed := applyProfileChanges(p, publicNameVO, input)
+		if len(changed) == 0 {
+			profile = p
+
+			return nil
+		}
+
+		if err = uc.profileRepository.Update(ctx, p); err != nil {
+			return err
+		}
+
+		profile, err = uc.profileRepository.GetByUserID(ctx, input.UserID)
+		if err != nil {
+			return err
+		}
+
+		return uc.outbox.Append(ctx, &events.ProfileUpdated{
+			UserID:        profile.UserID,
+			PublicName:    profile.PublicName.Value(),
+			ChangedFields: changed,
+			UpdatedAt:     profile.UpdatedAt,
+		})
+	})
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	return &dto.UpdateProfileOutputDTO{
+		Profile: profile,
+	}, nil
+}
+
+// applyProfileChanges copies the set input fields into p and returns the names of those that changed.
+func applyProfileChanges(p *user.Profile, publicName *publicname.PublicName, input *dto.UpdateProfileInputDTO) []string {
+	var changed []string
+
+	if publicName != nil && (p.PublicName == nil || p.PublicName.Value() != publicName.Value()) {
+		p.PublicName = publicName
+		changed = append(changed, "public_name")
+	}
+
+	texts := []struct {
+		name   string
+		value  *string
+		target **string
+	}{
+		{"bio", input.Bio, &p.Bio},
+		{"country_code", input.CountryCode, &p.CountryCode},
+		{"city", input.City, &p.City},
+		{"avatar_url", input.AvatarURL, &p.AvatarURL},
+		{"cover_image_url", input.CoverImageURL, &p.CoverImageURL},
+		{"website_url", input.WebsiteURL, &p.WebsiteURL},
+		{"twitch_username", input.TwitchUsername, &p.TwitchUsername},
+		{"youtube_channel_url", input.YoutubeChannelURL, &p.YoutubeChannelURL},
+	}
+
+	for _, field := range texts {
+		if field.value == nil {
+			continue
+		}
+
+		var next *string
+		if *field.value != "" {
+			value := *field.value
+			next = &value
+		}
+
+		if (*field.target == nil) != (next == nil) || (next != nil && **field.target != *next) {
+			*field.target = next
+			changed = append(changed, field.name)
+		}
+	}
+
+	if input.BirthDate != nil && (p.BirthDate == nil || !p.BirthDate.Equal(*input.BirthDate)) {
+		p.BirthDate = input.BirthDate
+		changed = append(changed, "birth_date")
+	}
+
+	if input.IsPublic != nil && p.IsPublic != *input.IsPublic {
+		p.IsPublic = *input.IsPublic
+		changed = append(changed, "is_public")
+	}
+
+	if input.ShowCountry != nil && p.ShowCountry != *input.ShowCountry {
+		p.ShowCountry = *input.ShowCountry
+		changed = append(changed, "show_country")
+	}
+
+	return changed
+}
+
+func validateLen(errs map[string]string, field string, value *string, maxLen int) {
+	if value != nil && len(*value) > maxLen {
+		errs[field] = "value is too long"
+	}
+}
+
+func validateURL(errs map[string]string, field string, value *string) {
+	if value == nil || *value == "" {
+		return
+	}
+
+	u, err := url.ParseRequestURI(*value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errs[field] = "value must be an http or https url"
+	}
+}
@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/events"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/email"
+)
+
+type (
+	UpdateUser UseCase[*dto.UpdateUserInputDTO, *dto.UpdateUserOutputDTO]
+
+	updateUser struct {
+		transactor            Transactor
+		userRepository        user.Repository
+		outbox                events.Outbox
+		sendEmailVerification SendEmailVerification
+	}
+)
+
+// NewUpdateUser marks a changed email unverified and sends a verification to the new address.
+func NewUpdateUser(
+	transactor Transactor,
+	repository user.Repository,
+	outbox events.Outbox,
+	sendEmailVerification SendEmailVerification,
+) UpdateUser {
+	return &updateUser{
+		transactor:            transactor,
+		userRepository:        repository,
+		outbox:                outbox,
+		sendEmailVerification: sendEmailVerification,
+	}
+}
+
+func (uc *updateUser) Execute(ctx context.Context, input *dto.UpdateUserInputDTO) (*dto.UpdateUserOutputDTO, error) {
+	errs := make(map[string]string)
+
+	var emailVO *email.Email
+
+	if input.Email != nil {
+		var err error
+		if emailVO, err = email.New(*input.Email); err != nil {
+			errs["email"] = err.Error()
+		}
+	}
+
+	if input.Language != nil && *input.Language == "" {
+		errs["language"] = "language required"
+	}
+
+	if len(errs) > 0 {
+		return nil, apperrors.NewInvalidArgumentError("validation failed", errs)
+	}
+
+	var (
+		u       *user.User
+		changed []string
+	)
+
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+
+		u, err = uc.userRepository.LockByID(ctx, input.UserID)
+		if err != nil {
+			return err
+		}
+
+		if emailVO != nil && u.Email().Value() != emailVO.Value() {
+			if err = u.ChangeEmail(emailVO); err != nil {
+				return err
+			}
+
+			changed = append(changed, "email")
+		}
+
+		if input.Language != nil && u.Language() != *input.Language {
+			if err = u.ChangeLanguage(*input.Language); err != nil {
+				return err
+			}
+
+			changed = append(changed, "language")
+		}
+
+		if len(changed) == 0 {
+			return nil
+		}
+
+		u, err = uc.userRepository.Update(ctx, u)
+		if err != nil {
+			return err
+		}
+
+		return uc.outbox.Append(ctx, &events.UserUpdated{
+			UserID:        u.ID(),
+			Email:         u.Email().Value(),
+			Language:      u.Language(),
+			ChangedFields: changed,
+			UpdatedAt:     u.UpdatedAt(),
+		})
+	})
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	if slices.Contains(changed, "email") {
+		// The email is changed at this point, a lost verification can be requested again.
+		_, _ = uc.sendEmailVerification.Execute(ctx, &dto.SendEmailVerificationInputDTO{
+			UserID: u.ID(),
+		})
+	}
+
+	return &dto.UpdateUserOutputDTO{
+		User: u,
+	}, nil
+}
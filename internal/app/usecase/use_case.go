@@ -5,3 +5,7 @@ import "context"
 type UseCase[Input comparable, Output comparable] interface {
 	Execute(ctx context.Context, input Input) (Output, error)
 }
+
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
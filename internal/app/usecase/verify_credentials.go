@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	apperrors "github.com/EugeneTsydenov/chesshub-user-service/internal/app/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/password"
+)
+
+type (
+	VerifyCredentials UseCase[*dto.VerifyCredentialsInputDTO, *dto.VerifyCredentialsOutputDTO]
+
+	verifyCredentials struct {
+		userRepository       user.Repository
+		credentialRepository user.Repository
+		hasher               password.Hasher
+	}
+)
+
+// NewVerifyCredentials looks users up in credentialRepository, which must hold their current password hash and
+// status, a cache does not. The login is recorded through repository.
+func NewVerifyCredentials(
+	repository user.Repository,
+	credentialRepository user.Repository,
+	hasher password.Hasher,
+) VerifyCredentials {
+	return &verifyCredentials{
+		userRepository:       repository,
+		credentialRepository: credentialRepository,
+		hasher:               hasher,
+	}
+}
+
+func (uc *verifyCredentials) Execute(
+	ctx context.Context,
+	input *dto.VerifyCredentialsInputDTO,
+) (*dto.VerifyCredentialsOutputDTO, error) {
+	errs := make(map[string]string)
+
+	if input.Email == "" && input.Tag == "" {
+		errs["login"] = "email or tag required"
+	}
+
+	if input.Password == "" {
+		errs["password"] = "password required"
+	}
+
+	if len(errs) > 0 {
+		return nil, apperrors.NewInvalidArgumentError("validation failed", errs)
+	}
+
+	u, err := uc.findUser(ctx, input)
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrUserNotFound) {
+			return nil, apperrors.FromDomainError(domainerrors.ErrInvalidCredentials)
+		}
+
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	if err = u.Password().Compare(input.Password, uc.hasher); err != nil {
+		return nil, apperrors.FromDomainError(domainerrors.ErrInvalidCredentials)
+	}
+
+	if err = u.EnsureCanLogin(); err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	u.RegisterLogin()
+
+	// The user was read without a lock, so only the login timestamps are written back.
+	if err = uc.userRepository.UpdateLogin(ctx, u); err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	return &dto.VerifyCredentialsOutputDTO{
+		User: u,
+	}, nil
+}
+
+func (uc *verifyCredentials) findUser(ctx context.Context, input *dto.VerifyCredentialsInputDTO) (*user.User, error) {
+	if input.Email != "" {
+		return uc.credentialRepository.GetByEmail(ctx, input.Email)
+	}
+
+	return uc.credentialRepository.GetByTag(ctx, input.Tag)
+}
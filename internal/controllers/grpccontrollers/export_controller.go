@@ -0,0 +1,67 @@
+package grpccontrollers
+
+import (
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	userproto "github.com/EugeneTsydenov/chesshub-user-service/internal/controllers/grpccontrollers/genproto"
+	"google.golang.org/grpc"
+)
+
+// exportChunkSize keeps messages well below the default 4 MiB gRPC limit.
+const exportChunkSize = 64 * 1024
+
+func (c *UserController) ExportUserData(
+	req *userproto.ExportUserDataRequest,
+	stream grpc.ServerStreamingServer[userproto.ExportUserDataChunk],
+) error {
+	w := &chunkWriter{stream: stream, buf: make([]byte, 0, exportChunkSize)}
+
+	_, err := c.useCases.ExportUserData.Execute(stream.Context(), &dto.ExportUserDataInputDTO{
+		UserID: req.GetUserId(),
+		Output: w,
+	})
+	if err != nil {
+		return err
+	}
+
+	return w.Flush()
+}
+
+// chunkWriter sends what is written to it as chunks of at most exportChunkSize bytes.
+type chunkWriter struct {
+	stream grpc.ServerStreamingServer[userproto.ExportUserDataChunk]
+	buf    []byte
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	written := 0
+
+	for len(p) > 0 {
+		n := min(len(p), exportChunkSize-len(w.buf))
+		w.buf = append(w.buf, p[:n]...)
+		p = p[n:]
+		written += n
+
+		if len(w.buf) == exportChunkSize {
+			if err := w.Flush(); err != nil {
+				return written, err
+			}
+		}
+	}
+
+	return written, nil
+}
+
+func (w *chunkWriter) Flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+
+	// A sent message must not be modified, the next chunk gets a buffer of its own.
+	if err := w.stream.Send(&userproto.ExportUserDataChunk{Data: w.buf}); err != nil {
+		return err
+	}
+
+	w.buf = make([]byte, 0, exportChunkSize)
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package grpccontrollers
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	userproto "github.com/EugeneTsydenov/chesshub-user-service/internal/controllers/grpccontrollers/genproto"
+)
+
+type recordingStream struct {
+	grpc.ServerStreamingServer[userproto.ExportUserDataChunk]
+
+	chunks [][]byte
+}
+
+func (s *recordingStream) Send(chunk *userproto.ExportUserDataChunk) error {
+	s.chunks = append(s.chunks, chunk.GetData())
+
+	return nil
+}
+
+func TestChunkWriter(t *testing.T) {
+	t.Run("should split writes into chunks that add up to the input", func(t *testing.T) {
+		stream := &recordingStream{}
+		w := &chunkWriter{stream: stream}
+
+		data := bytes.Repeat([]byte("0123456789"), exportChunkSize/4)
+
+		n, err := w.Write(data[:100])
+		require.NoError(t, err)
+		assert.Equal(t, 100, n)
+
+		_, err = w.Write(data[100:])
+		require.NoError(t, err)
+		require.NoError(t, w.Flush())
+
+		require.Len(t, stream.chunks, 3)
+		assert.Len(t, stream.chunks[0], exportChunkSize)
+		assert.Len(t, stream.chunks[1], exportChunkSize)
+		assert.Equal(t, data, bytes.Join(stream.chunks, nil))
+	})
+
+	t.Run("should not send empty chunks", func(t *testing.T) {
+		stream := &recordingStream{}
+
+		require.NoError(t, (&chunkWriter{stream: stream}).Flush())
+		assert.Empty(t, stream.chunks)
+	})
+}
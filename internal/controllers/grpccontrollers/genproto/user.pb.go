@@ -0,0 +1,6156 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: user.proto
+
+package userproto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UserStatus int32
+
+const (
+	UserStatus_USER_STATUS_UNSPECIFIED UserStatus = 0
+	UserStatus_USER_STATUS_ACTIVE      UserStatus = 1
+	UserStatus_USER_STATUS_SUSPENDED   UserStatus = 2
+	UserStatus_USER_STATUS_BANNED      UserStatus = 3
+	UserStatus_USER_STATUS_DELETED     UserStatus = 4
+)
+
+// Enum value maps for UserStatus.
+var (
+	UserStatus_name = map[int32]string{
+		0: "USER_STATUS_UNSPECIFIED",
+		1: "USER_STATUS_ACTIVE",
+		2: "USER_STATUS_SUSPENDED",
+		3: "USER_STATUS_BANNED",
+		4: "USER_STATUS_DELETED",
+	}
+	UserStatus_value = map[string]int32{
+		"USER_STATUS_UNSPECIFIED": 0,
+		"USER_STATUS_ACTIVE":      1,
+		"USER_STATUS_SUSPENDED":   2,
+		"USER_STATUS_BANNED":      3,
+		"USER_STATUS_DELETED":     4,
+	}
+)
+
+func (x UserStatus) Enum() *UserStatus {
+	p := new(UserStatus)
+	*p = x
+	return p
+}
+
+func (x UserStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_user_proto_enumTypes[0].Descriptor()
+}
+
+func (UserStatus) Type() protoreflect.EnumType {
+	return &file_user_proto_enumTypes[0]
+}
+
+func (x UserStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserStatus.Descriptor instead.
+func (UserStatus) EnumDescriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{0}
+}
+
+type ModerationAction int32
+
+const (
+	ModerationAction_MODERATION_ACTION_UNSPECIFIED ModerationAction = 0
+	ModerationAction_MODERATION_ACTION_SUSPEND     ModerationAction = 1
+	ModerationAction_MODERATION_ACTION_BAN         ModerationAction = 2
+	ModerationAction_MODERATION_ACTION_REINSTATE   ModerationAction = 3
+)
+
+// Enum value maps for ModerationAction.
+var (
+	ModerationAction_name = map[int32]string{
+		0: "MODERATION_ACTION_UNSPECIFIED",
+		1: "MODERATION_ACTION_SUSPEND",
+		2: "MODERATION_ACTION_BAN",
+		3: "MODERATION_ACTION_REINSTATE",
+	}
+	ModerationAction_value = map[string]int32{
+		"MODERATION_ACTION_UNSPECIFIED": 0,
+		"MODERATION_ACTION_SUSPEND":     1,
+		"MODERATION_ACTION_BAN":         2,
+		"MODERATION_ACTION_REINSTATE":   3,
+	}
+)
+
+func (x ModerationAction) Enum() *ModerationAction {
+	p := new(ModerationAction)
+	*p = x
+	return p
+}
+
+func (x ModerationAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModerationAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_user_proto_enumTypes[1].Descriptor()
+}
+
+func (ModerationAction) Type() protoreflect.EnumType {
+	return &file_user_proto_enumTypes[1]
+}
+
+func (x ModerationAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModerationAction.Descriptor instead.
+func (ModerationAction) EnumDescriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{1}
+}
+
+type TimeControl int32
+
+const (
+	TimeControl_TIME_CONTROL_UNSPECIFIED    TimeControl = 0
+	TimeControl_TIME_CONTROL_BULLET         TimeControl = 1
+	TimeControl_TIME_CONTROL_BLITZ          TimeControl = 2
+	TimeControl_TIME_CONTROL_RAPID          TimeControl = 3
+	TimeControl_TIME_CONTROL_CLASSICAL      TimeControl = 4
+	TimeControl_TIME_CONTROL_CORRESPONDENCE TimeControl = 5
+)
+
+// Enum value maps for TimeControl.
+var (
+	TimeControl_name = map[int32]string{
+		0: "TIME_CONTROL_UNSPECIFIED",
+		1: "TIME_CONTROL_BULLET",
+		2: "TIME_CONTROL_BLITZ",
+		3: "TIME_CONTROL_RAPID",
+		4: "TIME_CONTROL_CLASSICAL",
+		5: "TIME_CONTROL_CORRESPONDENCE",
+	}
+	TimeControl_value = map[string]int32{
+		"TIME_CONTROL_UNSPECIFIED":    0,
+		"TIME_CONTROL_BULLET":         1,
+		"TIME_CONTROL_BLITZ":          2,
+		"TIME_CONTROL_RAPID":          3,
+		"TIME_CONTROL_CLASSICAL":      4,
+		"TIME_CONTROL_CORRESPONDENCE": 5,
+	}
+)
+
+func (x TimeControl) Enum() *TimeControl {
+	p := new(TimeControl)
+	*p = x
+	return p
+}
+
+func (x TimeControl) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimeControl) Descriptor() protoreflect.EnumDescriptor {
+	return file_user_proto_enumTypes[2].Descriptor()
+}
+
+func (TimeControl) Type() protoreflect.EnumType {
+	return &file_user_proto_enumTypes[2]
+}
+
+func (x TimeControl) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimeControl.Descriptor instead.
+func (TimeControl) EnumDescriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{2}
+}
+
+type ChangeReason int32
+
+const (
+	ChangeReason_CHANGE_REASON_UNSPECIFIED  ChangeReason = 0
+	ChangeReason_CHANGE_REASON_GAME         ChangeReason = 1
+	ChangeReason_CHANGE_REASON_ADJUSTMENT   ChangeReason = 2
+	ChangeReason_CHANGE_REASON_SEASON_RESET ChangeReason = 3
+	ChangeReason_CHANGE_REASON_PENALTY      ChangeReason = 4
+	ChangeReason_CHANGE_REASON_REFUND       ChangeReason = 5
+)
+
+// Enum value maps for ChangeReason.
+var (
+	ChangeReason_name = map[int32]string{
+		0: "CHANGE_REASON_UNSPECIFIED",
+		1: "CHANGE_REASON_GAME",
+		2: "CHANGE_REASON_ADJUSTMENT",
+		3: "CHANGE_REASON_SEASON_RESET",
+		4: "CHANGE_REASON_PENALTY",
+		5: "CHANGE_REASON_REFUND",
+	}
+	ChangeReason_value = map[string]int32{
+		"CHANGE_REASON_UNSPECIFIED":  0,
+		"CHANGE_REASON_GAME":         1,
+		"CHANGE_REASON_ADJUSTMENT":   2,
+		"CHANGE_REASON_SEASON_RESET": 3,
+		"CHANGE_REASON_PENALTY":      4,
+		"CHANGE_REASON_REFUND":       5,
+	}
+)
+
+func (x ChangeReason) Enum() *ChangeReason {
+	p := new(ChangeReason)
+	*p = x
+	return p
+}
+
+func (x ChangeReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangeReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_user_proto_enumTypes[3].Descriptor()
+}
+
+func (ChangeReason) Type() protoreflect.EnumType {
+	return &file_user_proto_enumTypes[3]
+}
+
+func (x ChangeReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangeReason.Descriptor instead.
+func (ChangeReason) EnumDescriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{3}
+}
+
+type ChartInterval int32
+
+const (
+	ChartInterval_CHART_INTERVAL_UNSPECIFIED ChartInterval = 0
+	ChartInterval_CHART_INTERVAL_DAY         ChartInterval = 1
+	ChartInterval_CHART_INTERVAL_WEEK        ChartInterval = 2
+	ChartInterval_CHART_INTERVAL_MONTH       ChartInterval = 3
+)
+
+// Enum value maps for ChartInterval.
+var (
+	ChartInterval_name = map[int32]string{
+		0: "CHART_INTERVAL_UNSPECIFIED",
+		1: "CHART_INTERVAL_DAY",
+		2: "CHART_INTERVAL_WEEK",
+		3: "CHART_INTERVAL_MONTH",
+	}
+	ChartInterval_value = map[string]int32{
+		"CHART_INTERVAL_UNSPECIFIED": 0,
+		"CHART_INTERVAL_DAY":         1,
+		"CHART_INTERVAL_WEEK":        2,
+		"CHART_INTERVAL_MONTH":       3,
+	}
+)
+
+func (x ChartInterval) Enum() *ChartInterval {
+	p := new(ChartInterval)
+	*p = x
+	return p
+}
+
+func (x ChartInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChartInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_user_proto_enumTypes[4].Descriptor()
+}
+
+func (ChartInterval) Type() protoreflect.EnumType {
+	return &file_user_proto_enumTypes[4]
+}
+
+func (x ChartInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChartInterval.Descriptor instead.
+func (ChartInterval) EnumDescriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{4}
+}
+
+type GameOutcome int32
+
+const (
+	GameOutcome_GAME_OUTCOME_UNSPECIFIED GameOutcome = 0
+	GameOutcome_GAME_OUTCOME_WHITE_WINS  GameOutcome = 1
+	GameOutcome_GAME_OUTCOME_BLACK_WINS  GameOutcome = 2
+	GameOutcome_GAME_OUTCOME_DRAW        GameOutcome = 3
+)
+
+// Enum value maps for GameOutcome.
+var (
+	GameOutcome_name = map[int32]string{
+		0: "GAME_OUTCOME_UNSPECIFIED",
+		1: "GAME_OUTCOME_WHITE_WINS",
+		2: "GAME_OUTCOME_BLACK_WINS",
+		3: "GAME_OUTCOME_DRAW",
+	}
+	GameOutcome_value = map[string]int32{
+		"GAME_OUTCOME_UNSPECIFIED": 0,
+		"GAME_OUTCOME_WHITE_WINS":  1,
+		"GAME_OUTCOME_BLACK_WINS":  2,
+		"GAME_OUTCOME_DRAW":        3,
+	}
+)
+
+func (x GameOutcome) Enum() *GameOutcome {
+	p := new(GameOutcome)
+	*p = x
+	return p
+}
+
+func (x GameOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_user_proto_enumTypes[5].Descriptor()
+}
+
+func (GameOutcome) Type() protoreflect.EnumType {
+	return &file_user_proto_enumTypes[5]
+}
+
+func (x GameOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GameOutcome.Descriptor instead.
+func (GameOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{5}
+}
+
+type User struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tag             string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Email           string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Status          UserStatus             `protobuf:"varint,4,opt,name=status,proto3,enum=user.UserStatus" json:"status,omitempty"`
+	IsVerified      bool                   `protobuf:"varint,5,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	IsPremium       bool                   `protobuf:"varint,6,opt,name=is_premium,json=isPremium,proto3" json:"is_premium,omitempty"`
+	EmailVerifiedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=email_verified_at,json=emailVerifiedAt,proto3,oneof" json:"email_verified_at,omitempty"`
+	PremiumUntil    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=premium_until,json=premiumUntil,proto3,oneof" json:"premium_until,omitempty"`
+	Language        string                 `protobuf:"bytes,9,opt,name=language,proto3" json:"language,omitempty"`
+	LastActiveAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_active_at,json=lastActiveAt,proto3" json:"last_active_at,omitempty"`
+	LastLoginAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_login_at,json=lastLoginAt,proto3,oneof" json:"last_login_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Set while the user is suspended.
+	SuspendedUntil *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=suspended_until,json=suspendedUntil,proto3,oneof" json:"suspended_until,omitempty"`
+	// Set while the account is deleted and can still be restored.
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_user_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *User) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *User) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetStatus() UserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UserStatus_USER_STATUS_UNSPECIFIED
+}
+
+func (x *User) GetIsVerified() bool {
+	if x != nil {
+		return x.IsVerified
+	}
+	return false
+}
+
+func (x *User) GetIsPremium() bool {
+	if x != nil {
+		return x.IsPremium
+	}
+	return false
+}
+
+func (x *User) GetEmailVerifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EmailVerifiedAt
+	}
+	return nil
+}
+
+func (x *User) GetPremiumUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PremiumUntil
+	}
+	return nil
+}
+
+func (x *User) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *User) GetLastActiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastActiveAt
+	}
+	return nil
+}
+
+func (x *User) GetLastLoginAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastLoginAt
+	}
+	return nil
+}
+
+func (x *User) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *User) GetSuspendedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SuspendedUntil
+	}
+	return nil
+}
+
+func (x *User) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type Profile struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PublicName        string                 `protobuf:"bytes,2,opt,name=public_name,json=publicName,proto3" json:"public_name,omitempty"`
+	Bio               *string                `protobuf:"bytes,3,opt,name=bio,proto3,oneof" json:"bio,omitempty"`
+	CountryCode       *string                `protobuf:"bytes,4,opt,name=country_code,json=countryCode,proto3,oneof" json:"country_code,omitempty"`
+	City              *string                `protobuf:"bytes,5,opt,name=city,proto3,oneof" json:"city,omitempty"`
+	BirthDate         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=birth_date,json=birthDate,proto3,oneof" json:"birth_date,omitempty"`
+	AvatarUrl         *string                `protobuf:"bytes,7,opt,name=avatar_url,json=avatarUrl,proto3,oneof" json:"avatar_url,omitempty"`
+	CoverImageUrl     *string                `protobuf:"bytes,8,opt,name=cover_image_url,json=coverImageUrl,proto3,oneof" json:"cover_image_url,omitempty"`
+	IsPublic          bool                   `protobuf:"varint,9,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	ShowCountry       bool                   `protobuf:"varint,10,opt,name=show_country,json=showCountry,proto3" json:"show_country,omitempty"`
+	WebsiteUrl        *string                `protobuf:"bytes,11,opt,name=website_url,json=websiteUrl,proto3,oneof" json:"website_url,omitempty"`
+	TwitchUsername    *string                `protobuf:"bytes,12,opt,name=twitch_username,json=twitchUsername,proto3,oneof" json:"twitch_username,omitempty"`
+	YoutubeChannelUrl *string                `protobuf:"bytes,13,opt,name=youtube_channel_url,json=youtubeChannelUrl,proto3,oneof" json:"youtube_channel_url,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	mi := &file_user_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Profile) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Profile) GetPublicName() string {
+	if x != nil {
+		return x.PublicName
+	}
+	return ""
+}
+
+func (x *Profile) GetBio() string {
+	if x != nil && x.Bio != nil {
+		return *x.Bio
+	}
+	return ""
+}
+
+func (x *Profile) GetCountryCode() string {
+	if x != nil && x.CountryCode != nil {
+		return *x.CountryCode
+	}
+	return ""
+}
+
+func (x *Profile) GetCity() string {
+	if x != nil && x.City != nil {
+		return *x.City
+	}
+	return ""
+}
+
+func (x *Profile) GetBirthDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BirthDate
+	}
+	return nil
+}
+
+func (x *Profile) GetAvatarUrl() string {
+	if x != nil && x.AvatarUrl != nil {
+		return *x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *Profile) GetCoverImageUrl() string {
+	if x != nil && x.CoverImageUrl != nil {
+		return *x.CoverImageUrl
+	}
+	return ""
+}
+
+func (x *Profile) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+func (x *Profile) GetShowCountry() bool {
+	if x != nil {
+		return x.ShowCountry
+	}
+	return false
+}
+
+func (x *Profile) GetWebsiteUrl() string {
+	if x != nil && x.WebsiteUrl != nil {
+		return *x.WebsiteUrl
+	}
+	return ""
+}
+
+func (x *Profile) GetTwitchUsername() string {
+	if x != nil && x.TwitchUsername != nil {
+		return *x.TwitchUsername
+	}
+	return ""
+}
+
+func (x *Profile) GetYoutubeChannelUrl() string {
+	if x != nil && x.YoutubeChannelUrl != nil {
+		return *x.YoutubeChannelUrl
+	}
+	return ""
+}
+
+func (x *Profile) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Profile) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type Rating struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId       int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TimeControl  TimeControl            `protobuf:"varint,3,opt,name=time_control,json=timeControl,proto3,enum=user.TimeControl" json:"time_control,omitempty"`
+	Rating       int32                  `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"`
+	PeakRating   int32                  `protobuf:"varint,5,opt,name=peak_rating,json=peakRating,proto3" json:"peak_rating,omitempty"`
+	LowestRating int32                  `protobuf:"varint,6,opt,name=lowest_rating,json=lowestRating,proto3" json:"lowest_rating,omitempty"`
+	GamesPlayed  int32                  `protobuf:"varint,7,opt,name=games_played,json=gamesPlayed,proto3" json:"games_played,omitempty"`
+	Wins         int32                  `protobuf:"varint,8,opt,name=wins,proto3" json:"wins,omitempty"`
+	Losses       int32                  `protobuf:"varint,9,opt,name=losses,proto3" json:"losses,omitempty"`
+	Draws        int32                  `protobuf:"varint,10,opt,name=draws,proto3" json:"draws,omitempty"`
+	LastGameAt   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_game_at,json=lastGameAt,proto3,oneof" json:"last_game_at,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Deviation    float64                `protobuf:"fixed64,14,opt,name=deviation,proto3" json:"deviation,omitempty"`
+	Volatility   float64                `protobuf:"fixed64,15,opt,name=volatility,proto3" json:"volatility,omitempty"`
+	// Set during the placement games of the time control. Provisional ratings are not ranked.
+	Provisional   bool `protobuf:"varint,16,opt,name=provisional,proto3" json:"provisional,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rating) Reset() {
+	*x = Rating{}
+	mi := &file_user_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rating) ProtoMessage() {}
+
+func (x *Rating) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rating.ProtoReflect.Descriptor instead.
+func (*Rating) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Rating) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Rating) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Rating) GetTimeControl() TimeControl {
+	if x != nil {
+		return x.TimeControl
+	}
+	return TimeControl_TIME_CONTROL_UNSPECIFIED
+}
+
+func (x *Rating) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Rating) GetPeakRating() int32 {
+	if x != nil {
+		return x.PeakRating
+	}
+	return 0
+}
+
+func (x *Rating) GetLowestRating() int32 {
+	if x != nil {
+		return x.LowestRating
+	}
+	return 0
+}
+
+func (x *Rating) GetGamesPlayed() int32 {
+	if x != nil {
+		return x.GamesPlayed
+	}
+	return 0
+}
+
+func (x *Rating) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *Rating) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *Rating) GetDraws() int32 {
+	if x != nil {
+		return x.Draws
+	}
+	return 0
+}
+
+func (x *Rating) GetLastGameAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastGameAt
+	}
+	return nil
+}
+
+func (x *Rating) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Rating) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Rating) GetDeviation() float64 {
+	if x != nil {
+		return x.Deviation
+	}
+	return 0
+}
+
+func (x *Rating) GetVolatility() float64 {
+	if x != nil {
+		return x.Volatility
+	}
+	return 0
+}
+
+func (x *Rating) GetProvisional() bool {
+	if x != nil {
+		return x.Provisional
+	}
+	return false
+}
+
+type RatingHistory struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId       int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TimeControl  TimeControl            `protobuf:"varint,3,opt,name=time_control,json=timeControl,proto3,enum=user.TimeControl" json:"time_control,omitempty"`
+	OldRating    int32                  `protobuf:"varint,4,opt,name=old_rating,json=oldRating,proto3" json:"old_rating,omitempty"`
+	NewRating    int32                  `protobuf:"varint,5,opt,name=new_rating,json=newRating,proto3" json:"new_rating,omitempty"`
+	RatingRange  int32                  `protobuf:"varint,6,opt,name=rating_range,json=ratingRange,proto3" json:"rating_range,omitempty"`
+	GameId       *string                `protobuf:"bytes,7,opt,name=game_id,json=gameId,proto3,oneof" json:"game_id,omitempty"`
+	ChangeReason ChangeReason           `protobuf:"varint,8,opt,name=change_reason,json=changeReason,proto3,enum=user.ChangeReason" json:"change_reason,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Admin who changed the rating by hand, unset for changes made by games.
+	ActorId       *int64 `protobuf:"varint,10,opt,name=actor_id,json=actorId,proto3,oneof" json:"actor_id,omitempty"`
+	Note          string `protobuf:"bytes,11,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RatingHistory) Reset() {
+	*x = RatingHistory{}
+	mi := &file_user_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatingHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingHistory) ProtoMessage() {}
+
+func (x *RatingHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingHistory.ProtoReflect.Descriptor instead.
+func (*RatingHistory) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RatingHistory) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RatingHistory) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RatingHistory) GetTimeControl() TimeControl {
+	if x != nil {
+		return x.TimeControl
+	}
+	return TimeControl_TIME_CONTROL_UNSPECIFIED
+}
+
+func (x *RatingHistory) GetOldRating() int32 {
+	if x != nil {
+		return x.OldRating
+	}
+	return 0
+}
+
+func (x *RatingHistory) GetNewRating() int32 {
+	if x != nil {
+		return x.NewRating
+	}
+	return 0
+}
+
+func (x *RatingHistory) GetRatingRange() int32 {
+	if x != nil {
+		return x.RatingRange
+	}
+	return 0
+}
+
+func (x *RatingHistory) GetGameId() string {
+	if x != nil && x.GameId != nil {
+		return *x.GameId
+	}
+	return ""
+}
+
+func (x *RatingHistory) GetChangeReason() ChangeReason {
+	if x != nil {
+		return x.ChangeReason
+	}
+	return ChangeReason_CHANGE_REASON_UNSPECIFIED
+}
+
+func (x *RatingHistory) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RatingHistory) GetActorId() int64 {
+	if x != nil && x.ActorId != nil {
+		return *x.ActorId
+	}
+	return 0
+}
+
+func (x *RatingHistory) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type RegisterUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	PublicName    string                 `protobuf:"bytes,2,opt,name=public_name,json=publicName,proto3" json:"public_name,omitempty"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	CountryCode   string                 `protobuf:"bytes,4,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	Language      string                 `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterUserRequest) Reset() {
+	*x = RegisterUserRequest{}
+	mi := &file_user_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterUserRequest) ProtoMessage() {}
+
+func (x *RegisterUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterUserRequest.ProtoReflect.Descriptor instead.
+func (*RegisterUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RegisterUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RegisterUserRequest) GetPublicName() string {
+	if x != nil {
+		return x.PublicName
+	}
+	return ""
+}
+
+func (x *RegisterUserRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *RegisterUserRequest) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *RegisterUserRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type RegisterUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterUserResponse) Reset() {
+	*x = RegisterUserResponse{}
+	mi := &file_user_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterUserResponse) ProtoMessage() {}
+
+func (x *RegisterUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterUserResponse.ProtoReflect.Descriptor instead.
+func (*RegisterUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RegisterUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type VerifyCredentialsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Login:
+	//
+	//	*VerifyCredentialsRequest_Email
+	//	*VerifyCredentialsRequest_Tag
+	Login         isVerifyCredentialsRequest_Login `protobuf_oneof:"login"`
+	Password      string                           `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyCredentialsRequest) Reset() {
+	*x = VerifyCredentialsRequest{}
+	mi := &file_user_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyCredentialsRequest) ProtoMessage() {}
+
+func (x *VerifyCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*VerifyCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *VerifyCredentialsRequest) GetLogin() isVerifyCredentialsRequest_Login {
+	if x != nil {
+		return x.Login
+	}
+	return nil
+}
+
+func (x *VerifyCredentialsRequest) GetEmail() string {
+	if x != nil {
+		if x, ok := x.Login.(*VerifyCredentialsRequest_Email); ok {
+			return x.Email
+		}
+	}
+	return ""
+}
+
+func (x *VerifyCredentialsRequest) GetTag() string {
+	if x != nil {
+		if x, ok := x.Login.(*VerifyCredentialsRequest_Tag); ok {
+			return x.Tag
+		}
+	}
+	return ""
+}
+
+func (x *VerifyCredentialsRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type isVerifyCredentialsRequest_Login interface {
+	isVerifyCredentialsRequest_Login()
+}
+
+type VerifyCredentialsRequest_Email struct {
+	Email string `protobuf:"bytes,1,opt,name=email,proto3,oneof"`
+}
+
+type VerifyCredentialsRequest_Tag struct {
+	Tag string `protobuf:"bytes,2,opt,name=tag,proto3,oneof"`
+}
+
+func (*VerifyCredentialsRequest_Email) isVerifyCredentialsRequest_Login() {}
+
+func (*VerifyCredentialsRequest_Tag) isVerifyCredentialsRequest_Login() {}
+
+type VerifyCredentialsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyCredentialsResponse) Reset() {
+	*x = VerifyCredentialsResponse{}
+	mi := &file_user_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyCredentialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyCredentialsResponse) ProtoMessage() {}
+
+func (x *VerifyCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*VerifyCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *VerifyCredentialsResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type SendEmailVerificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendEmailVerificationRequest) Reset() {
+	*x = SendEmailVerificationRequest{}
+	mi := &file_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendEmailVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendEmailVerificationRequest) ProtoMessage() {}
+
+func (x *SendEmailVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendEmailVerificationRequest.ProtoReflect.Descriptor instead.
+func (*SendEmailVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SendEmailVerificationRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type SendEmailVerificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendEmailVerificationResponse) Reset() {
+	*x = SendEmailVerificationResponse{}
+	mi := &file_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendEmailVerificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendEmailVerificationResponse) ProtoMessage() {}
+
+func (x *SendEmailVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendEmailVerificationResponse.ProtoReflect.Descriptor instead.
+func (*SendEmailVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SendEmailVerificationResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ConfirmEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmEmailRequest) Reset() {
+	*x = ConfirmEmailRequest{}
+	mi := &file_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmEmailRequest) ProtoMessage() {}
+
+func (x *ConfirmEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmEmailRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmEmailRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ConfirmEmailRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ConfirmEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmEmailResponse) Reset() {
+	*x = ConfirmEmailResponse{}
+	mi := &file_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmEmailResponse) ProtoMessage() {}
+
+func (x *ConfirmEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmEmailResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmEmailResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ConfirmEmailResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type RequestPasswordResetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	mi := &file_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RequestPasswordResetRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type RequestPasswordResetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetResponse) Reset() {
+	*x = RequestPasswordResetResponse{}
+	mi := &file_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetResponse) ProtoMessage() {}
+
+func (x *RequestPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RequestPasswordResetResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ConfirmPasswordResetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmPasswordResetRequest) Reset() {
+	*x = ConfirmPasswordResetRequest{}
+	mi := &file_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPasswordResetRequest) ProtoMessage() {}
+
+func (x *ConfirmPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ConfirmPasswordResetRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ConfirmPasswordResetRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ConfirmPasswordResetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmPasswordResetResponse) Reset() {
+	*x = ConfirmPasswordResetResponse{}
+	mi := &file_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPasswordResetResponse) ProtoMessage() {}
+
+func (x *ConfirmPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ConfirmPasswordResetResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ChangePasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OldPassword   string                 `protobuf:"bytes,2,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ChangePasswordRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ChangePasswordRequest) GetOldPassword() string {
+	if x != nil {
+		return x.OldPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ChangePasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordResponse) Reset() {
+	*x = ChangePasswordResponse{}
+	mi := &file_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordResponse) ProtoMessage() {}
+
+func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ChangePasswordResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetUserRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetUserByTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserByTagRequest) Reset() {
+	*x = GetUserByTagRequest{}
+	mi := &file_user_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserByTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserByTagRequest) ProtoMessage() {}
+
+func (x *GetUserByTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserByTagRequest.ProtoReflect.Descriptor instead.
+func (*GetUserByTagRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetUserByTagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type GetUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	User  *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// Set when the requested tag is an earlier tag of the user.
+	RedirectedFrom *string `protobuf:"bytes,2,opt,name=redirected_from,json=redirectedFrom,proto3,oneof" json:"redirected_from,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetUserResponse) Reset() {
+	*x = GetUserResponse{}
+	mi := &file_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserResponse) ProtoMessage() {}
+
+func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
+func (*GetUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *GetUserResponse) GetRedirectedFrom() string {
+	if x != nil && x.RedirectedFrom != nil {
+		return *x.RedirectedFrom
+	}
+	return ""
+}
+
+type UpdateUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// A new email is unverified until the verification sent to it is confirmed.
+	Email         *string `protobuf:"bytes,2,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	Language      *string `protobuf:"bytes,3,opt,name=language,proto3,oneof" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRequest) Reset() {
+	*x = UpdateUserRequest{}
+	mi := &file_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRequest) ProtoMessage() {}
+
+func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateUserRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateUserRequest) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetLanguage() string {
+	if x != nil && x.Language != nil {
+		return *x.Language
+	}
+	return ""
+}
+
+type UpdateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserResponse) Reset() {
+	*x = UpdateUserResponse{}
+	mi := &file_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserResponse) ProtoMessage() {}
+
+func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type ChangeTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeTagRequest) Reset() {
+	*x = ChangeTagRequest{}
+	mi := &file_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeTagRequest) ProtoMessage() {}
+
+func (x *ChangeTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeTagRequest.ProtoReflect.Descriptor instead.
+func (*ChangeTagRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ChangeTagRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ChangeTagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type ChangeTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeTagResponse) Reset() {
+	*x = ChangeTagResponse{}
+	mi := &file_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeTagResponse) ProtoMessage() {}
+
+func (x *ChangeTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeTagResponse.ProtoReflect.Descriptor instead.
+func (*ChangeTagResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ChangeTagResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type DeleteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
+	mi := &file_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserRequest) ProtoMessage() {}
+
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteUserRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteUserResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Message string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// Time left until the account is permanently removed.
+	GracePeriod   *durationpb.Duration `protobuf:"bytes,2,opt,name=grace_period,json=gracePeriod,proto3,oneof" json:"grace_period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserResponse) Reset() {
+	*x = DeleteUserResponse{}
+	mi := &file_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserResponse) ProtoMessage() {}
+
+func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeleteUserResponse) GetGracePeriod() *durationpb.Duration {
+	if x != nil {
+		return x.GracePeriod
+	}
+	return nil
+}
+
+type RestoreUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreUserRequest) Reset() {
+	*x = RestoreUserRequest{}
+	mi := &file_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreUserRequest) ProtoMessage() {}
+
+func (x *RestoreUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreUserRequest.ProtoReflect.Descriptor instead.
+func (*RestoreUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RestoreUserRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RestoreUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreUserResponse) Reset() {
+	*x = RestoreUserResponse{}
+	mi := &file_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreUserResponse) ProtoMessage() {}
+
+func (x *RestoreUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreUserResponse.ProtoReflect.Descriptor instead.
+func (*RestoreUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RestoreUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type ExportUserDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataRequest) Reset() {
+	*x = ExportUserDataRequest{}
+	mi := &file_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataRequest) ProtoMessage() {}
+
+func (x *ExportUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ExportUserDataRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// Concatenated in order, the chunks of a response form one JSON document.
+type ExportUserDataChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataChunk) Reset() {
+	*x = ExportUserDataChunk{}
+	mi := &file_user_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataChunk) ProtoMessage() {}
+
+func (x *ExportUserDataChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataChunk.ProtoReflect.Descriptor instead.
+func (*ExportUserDataChunk) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ExportUserDataChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileRequest) Reset() {
+	*x = GetProfileRequest{}
+	mi := &file_user_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileRequest) ProtoMessage() {}
+
+func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetProfileRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetProfileResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The whole profile as its owner sees it, including fields hidden from other users.
+	Profile       *Profile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileResponse) Reset() {
+	*x = GetProfileResponse{}
+	mi := &file_user_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileResponse) ProtoMessage() {}
+
+func (x *GetProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetProfileResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetProfileResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type UpdateProfileRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PublicName        *string                `protobuf:"bytes,2,opt,name=public_name,json=publicName,proto3,oneof" json:"public_name,omitempty"`
+	Bio               *string                `protobuf:"bytes,3,opt,name=bio,proto3,oneof" json:"bio,omitempty"`
+	CountryCode       *string                `protobuf:"bytes,4,opt,name=country_code,json=countryCode,proto3,oneof" json:"country_code,omitempty"`
+	City              *string                `protobuf:"bytes,5,opt,name=city,proto3,oneof" json:"city,omitempty"`
+	BirthDate         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=birth_date,json=birthDate,proto3,oneof" json:"birth_date,omitempty"`
+	AvatarUrl         *string                `protobuf:"bytes,7,opt,name=avatar_url,json=avatarUrl,proto3,oneof" json:"avatar_url,omitempty"`
+	CoverImageUrl     *string                `protobuf:"bytes,8,opt,name=cover_image_url,json=coverImageUrl,proto3,oneof" json:"cover_image_url,omitempty"`
+	IsPublic          *bool                  `protobuf:"varint,9,opt,name=is_public,json=isPublic,proto3,oneof" json:"is_public,omitempty"`
+	ShowCountry       *bool                  `protobuf:"varint,10,opt,name=show_country,json=showCountry,proto3,oneof" json:"show_country,omitempty"`
+	WebsiteUrl        *string                `protobuf:"bytes,11,opt,name=website_url,json=websiteUrl,proto3,oneof" json:"website_url,omitempty"`
+	TwitchUsername    *string                `protobuf:"bytes,12,opt,name=twitch_username,json=twitchUsername,proto3,oneof" json:"twitch_username,omitempty"`
+	YoutubeChannelUrl *string                `protobuf:"bytes,13,opt,name=youtube_channel_url,json=youtubeChannelUrl,proto3,oneof" json:"youtube_channel_url,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
+	mi := &file_user_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileRequest) ProtoMessage() {}
+
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateProfileRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateProfileRequest) GetPublicName() string {
+	if x != nil && x.PublicName != nil {
+		return *x.PublicName
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetBio() string {
+	if x != nil && x.Bio != nil {
+		return *x.Bio
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetCountryCode() string {
+	if x != nil && x.CountryCode != nil {
+		return *x.CountryCode
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetCity() string {
+	if x != nil && x.City != nil {
+		return *x.City
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetBirthDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BirthDate
+	}
+	return nil
+}
+
+func (x *UpdateProfileRequest) GetAvatarUrl() string {
+	if x != nil && x.AvatarUrl != nil {
+		return *x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetCoverImageUrl() string {
+	if x != nil && x.CoverImageUrl != nil {
+		return *x.CoverImageUrl
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetIsPublic() bool {
+	if x != nil && x.IsPublic != nil {
+		return *x.IsPublic
+	}
+	return false
+}
+
+func (x *UpdateProfileRequest) GetShowCountry() bool {
+	if x != nil && x.ShowCountry != nil {
+		return *x.ShowCountry
+	}
+	return false
+}
+
+func (x *UpdateProfileRequest) GetWebsiteUrl() string {
+	if x != nil && x.WebsiteUrl != nil {
+		return *x.WebsiteUrl
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetTwitchUsername() string {
+	if x != nil && x.TwitchUsername != nil {
+		return *x.TwitchUsername
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetYoutubeChannelUrl() string {
+	if x != nil && x.YoutubeChannelUrl != nil {
+		return *x.YoutubeChannelUrl
+	}
+	return ""
+}
+
+type UpdateProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileResponse) Reset() {
+	*x = UpdateProfileResponse{}
+	mi := &file_user_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileResponse) ProtoMessage() {}
+
+func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UpdateProfileResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type GetPublicProfilesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// At most 500 ids, duplicates are ignored.
+	UserIds       []int64 `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicProfilesRequest) Reset() {
+	*x = GetPublicProfilesRequest{}
+	mi := &file_user_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicProfilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicProfilesRequest) ProtoMessage() {}
+
+func (x *GetPublicProfilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicProfilesRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetPublicProfilesRequest) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type GetPublicProfilesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Profiles as seen by other users, in request order. Unknown, deleted and banned users are skipped.
+	Profiles      []*Profile `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicProfilesResponse) Reset() {
+	*x = GetPublicProfilesResponse{}
+	mi := &file_user_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicProfilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicProfilesResponse) ProtoMessage() {}
+
+func (x *GetPublicProfilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicProfilesResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetPublicProfilesResponse) GetProfiles() []*Profile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+type GetRatingsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Restricts the response to a single time control when set.
+	TimeControl *TimeControl `protobuf:"varint,2,opt,name=time_control,json=timeControl,proto3,enum=user.TimeControl,oneof" json:"time_control,omitempty"`
+	// Number of rating history entries returned per time control.
+	HistoryLimit  int32 `protobuf:"varint,3,opt,name=history_limit,json=historyLimit,proto3" json:"history_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRatingsRequest) Reset() {
+	*x = GetRatingsRequest{}
+	mi := &file_user_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRatingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRatingsRequest) ProtoMessage() {}
+
+func (x *GetRatingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRatingsRequest.ProtoReflect.Descriptor instead.
+func (*GetRatingsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetRatingsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetRatingsRequest) GetTimeControl() TimeControl {
+	if x != nil && x.TimeControl != nil {
+		return *x.TimeControl
+	}
+	return TimeControl_TIME_CONTROL_UNSPECIFIED
+}
+
+func (x *GetRatingsRequest) GetHistoryLimit() int32 {
+	if x != nil {
+		return x.HistoryLimit
+	}
+	return 0
+}
+
+type GetRatingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ratings       []*Rating              `protobuf:"bytes,1,rep,name=ratings,proto3" json:"ratings,omitempty"`
+	History       []*RatingHistory       `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRatingsResponse) Reset() {
+	*x = GetRatingsResponse{}
+	mi := &file_user_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRatingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRatingsResponse) ProtoMessage() {}
+
+func (x *GetRatingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRatingsResponse.ProtoReflect.Descriptor instead.
+func (*GetRatingsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetRatingsResponse) GetRatings() []*Rating {
+	if x != nil {
+		return x.Ratings
+	}
+	return nil
+}
+
+func (x *GetRatingsResponse) GetHistory() []*RatingHistory {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+// Without from and to the chart covers the last 90 days.
+type GetRatingChartRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	UserId      int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TimeControl TimeControl            `protobuf:"varint,2,opt,name=time_control,json=timeControl,proto3,enum=user.TimeControl" json:"time_control,omitempty"`
+	// Defaults to days.
+	Interval ChartInterval          `protobuf:"varint,3,opt,name=interval,proto3,enum=user.ChartInterval" json:"interval,omitempty"`
+	From     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	// Defaults to now.
+	To            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRatingChartRequest) Reset() {
+	*x = GetRatingChartRequest{}
+	mi := &file_user_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRatingChartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRatingChartRequest) ProtoMessage() {}
+
+func (x *GetRatingChartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRatingChartRequest.ProtoReflect.Descriptor instead.
+func (*GetRatingChartRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetRatingChartRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetRatingChartRequest) GetTimeControl() TimeControl {
+	if x != nil {
+		return x.TimeControl
+	}
+	return TimeControl_TIME_CONTROL_UNSPECIFIED
+}
+
+func (x *GetRatingChartRequest) GetInterval() ChartInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return ChartInterval_CHART_INTERVAL_UNSPECIFIED
+}
+
+func (x *GetRatingChartRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetRatingChartRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+// RatingBucket summarises the rating changes within one interval. Buckets without changes are left out.
+type RatingBucket struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Start of the interval in UTC, weeks start on Monday.
+	Start *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	// Rating before the first change of the interval.
+	Open int32 `protobuf:"varint,2,opt,name=open,proto3" json:"open,omitempty"`
+	// Rating after the last change of the interval.
+	Close         int32 `protobuf:"varint,3,opt,name=close,proto3" json:"close,omitempty"`
+	Min           int32 `protobuf:"varint,4,opt,name=min,proto3" json:"min,omitempty"`
+	Max           int32 `protobuf:"varint,5,opt,name=max,proto3" json:"max,omitempty"`
+	Games         int32 `protobuf:"varint,6,opt,name=games,proto3" json:"games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RatingBucket) Reset() {
+	*x = RatingBucket{}
+	mi := &file_user_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatingBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingBucket) ProtoMessage() {}
+
+func (x *RatingBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingBucket.ProtoReflect.Descriptor instead.
+func (*RatingBucket) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RatingBucket) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *RatingBucket) GetOpen() int32 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *RatingBucket) GetClose() int32 {
+	if x != nil {
+		return x.Close
+	}
+	return 0
+}
+
+func (x *RatingBucket) GetMin() int32 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *RatingBucket) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *RatingBucket) GetGames() int32 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
+}
+
+type GetRatingChartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interval      ChartInterval          `protobuf:"varint,1,opt,name=interval,proto3,enum=user.ChartInterval" json:"interval,omitempty"`
+	Buckets       []*RatingBucket        `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRatingChartResponse) Reset() {
+	*x = GetRatingChartResponse{}
+	mi := &file_user_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRatingChartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRatingChartResponse) ProtoMessage() {}
+
+func (x *GetRatingChartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRatingChartResponse.ProtoReflect.Descriptor instead.
+func (*GetRatingChartResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetRatingChartResponse) GetInterval() ChartInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return ChartInterval_CHART_INTERVAL_UNSPECIFIED
+}
+
+func (x *GetRatingChartResponse) GetBuckets() []*RatingBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+type AdjustRatingRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	UserId      int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TimeControl TimeControl            `protobuf:"varint,2,opt,name=time_control,json=timeControl,proto3,enum=user.TimeControl" json:"time_control,omitempty"`
+	// Types that are valid to be assigned to Change:
+	//
+	//	*AdjustRatingRequest_Delta
+	//	*AdjustRatingRequest_Value
+	Change isAdjustRatingRequest_Change `protobuf_oneof:"change"`
+	// Admin making the change.
+	ActorId       int64  `protobuf:"varint,5,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Note          string `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustRatingRequest) Reset() {
+	*x = AdjustRatingRequest{}
+	mi := &file_user_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustRatingRequest) ProtoMessage() {}
+
+func (x *AdjustRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustRatingRequest.ProtoReflect.Descriptor instead.
+func (*AdjustRatingRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AdjustRatingRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdjustRatingRequest) GetTimeControl() TimeControl {
+	if x != nil {
+		return x.TimeControl
+	}
+	return TimeControl_TIME_CONTROL_UNSPECIFIED
+}
+
+func (x *AdjustRatingRequest) GetChange() isAdjustRatingRequest_Change {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+func (x *AdjustRatingRequest) GetDelta() int32 {
+	if x != nil {
+		if x, ok := x.Change.(*AdjustRatingRequest_Delta); ok {
+			return x.Delta
+		}
+	}
+	return 0
+}
+
+func (x *AdjustRatingRequest) GetValue() int32 {
+	if x != nil {
+		if x, ok := x.Change.(*AdjustRatingRequest_Value); ok {
+			return x.Value
+		}
+	}
+	return 0
+}
+
+func (x *AdjustRatingRequest) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *AdjustRatingRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type isAdjustRatingRequest_Change interface {
+	isAdjustRatingRequest_Change()
+}
+
+type AdjustRatingRequest_Delta struct {
+	Delta int32 `protobuf:"varint,3,opt,name=delta,proto3,oneof"`
+}
+
+type AdjustRatingRequest_Value struct {
+	Value int32 `protobuf:"varint,4,opt,name=value,proto3,oneof"`
+}
+
+func (*AdjustRatingRequest_Delta) isAdjustRatingRequest_Change() {}
+
+func (*AdjustRatingRequest_Value) isAdjustRatingRequest_Change() {}
+
+// A penalty never takes the rating below 100.
+type PenalizeRatingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TimeControl   TimeControl            `protobuf:"varint,2,opt,name=time_control,json=timeControl,proto3,enum=user.TimeControl" json:"time_control,omitempty"`
+	Points        int32                  `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+	ActorId       int64                  `protobuf:"varint,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PenalizeRatingRequest) Reset() {
+	*x = PenalizeRatingRequest{}
+	mi := &file_user_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PenalizeRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PenalizeRatingRequest) ProtoMessage() {}
+
+func (x *PenalizeRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PenalizeRatingRequest.ProtoReflect.Descriptor instead.
+func (*PenalizeRatingRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PenalizeRatingRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PenalizeRatingRequest) GetTimeControl() TimeControl {
+	if x != nil {
+		return x.TimeControl
+	}
+	return TimeControl_TIME_CONTROL_UNSPECIFIED
+}
+
+func (x *PenalizeRatingRequest) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *PenalizeRatingRequest) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *PenalizeRatingRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type AdjustRatingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rating        *Rating                `protobuf:"bytes,1,opt,name=rating,proto3" json:"rating,omitempty"`
+	History       *RatingHistory         `protobuf:"bytes,2,opt,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustRatingResponse) Reset() {
+	*x = AdjustRatingResponse{}
+	mi := &file_user_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustRatingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustRatingResponse) ProtoMessage() {}
+
+func (x *AdjustRatingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustRatingResponse.ProtoReflect.Descriptor instead.
+func (*AdjustRatingResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AdjustRatingResponse) GetRating() *Rating {
+	if x != nil {
+		return x.Rating
+	}
+	return nil
+}
+
+func (x *AdjustRatingResponse) GetHistory() *RatingHistory {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+// Gives the opponents of a cheater back the rating they lost in the listed games. Games are refunded at most once.
+type RefundOpponentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CheaterId     int64                  `protobuf:"varint,1,opt,name=cheater_id,json=cheaterId,proto3" json:"cheater_id,omitempty"`
+	GameIds       []string               `protobuf:"bytes,2,rep,name=game_ids,json=gameIds,proto3" json:"game_ids,omitempty"`
+	ActorId       int64                  `protobuf:"varint,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundOpponentsRequest) Reset() {
+	*x = RefundOpponentsRequest{}
+	mi := &file_user_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundOpponentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundOpponentsRequest) ProtoMessage() {}
+
+func (x *RefundOpponentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundOpponentsRequest.ProtoReflect.Descriptor instead.
+func (*RefundOpponentsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RefundOpponentsRequest) GetCheaterId() int64 {
+	if x != nil {
+		return x.CheaterId
+	}
+	return 0
+}
+
+func (x *RefundOpponentsRequest) GetGameIds() []string {
+	if x != nil {
+		return x.GameIds
+	}
+	return nil
+}
+
+func (x *RefundOpponentsRequest) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *RefundOpponentsRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type RefundOpponentsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Refunds []*RatingHistory       `protobuf:"bytes,1,rep,name=refunds,proto3" json:"refunds,omitempty"`
+	// Games not played by the cheater, not lost by the opponent or already refunded.
+	SkippedGameIds []string `protobuf:"bytes,2,rep,name=skipped_game_ids,json=skippedGameIds,proto3" json:"skipped_game_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RefundOpponentsResponse) Reset() {
+	*x = RefundOpponentsResponse{}
+	mi := &file_user_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundOpponentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundOpponentsResponse) ProtoMessage() {}
+
+func (x *RefundOpponentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundOpponentsResponse.ProtoReflect.Descriptor instead.
+func (*RefundOpponentsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RefundOpponentsResponse) GetRefunds() []*RatingHistory {
+	if x != nil {
+		return x.Refunds
+	}
+	return nil
+}
+
+func (x *RefundOpponentsResponse) GetSkippedGameIds() []string {
+	if x != nil {
+		return x.SkippedGameIds
+	}
+	return nil
+}
+
+type Season struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ended_at,json=endedAt,proto3,oneof" json:"ended_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Season) Reset() {
+	*x = Season{}
+	mi := &file_user_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Season) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Season) ProtoMessage() {}
+
+func (x *Season) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Season.ProtoReflect.Descriptor instead.
+func (*Season) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Season) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Season) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Season) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Season) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+// Ends the season in progress and starts the next one. Standings of the ended season are archived and its ratings
+// are compressed toward the mean in the background.
+type StartSeasonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartSeasonRequest) Reset() {
+	*x = StartSeasonRequest{}
+	mi := &file_user_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartSeasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSeasonRequest) ProtoMessage() {}
+
+func (x *StartSeasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSeasonRequest.ProtoReflect.Descriptor instead.
+func (*StartSeasonRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *StartSeasonRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type StartSeasonResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Season *Season                `protobuf:"bytes,1,opt,name=season,proto3" json:"season,omitempty"`
+	// Unset when no season was in progress.
+	Ended         *Season `protobuf:"bytes,2,opt,name=ended,proto3,oneof" json:"ended,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartSeasonResponse) Reset() {
+	*x = StartSeasonResponse{}
+	mi := &file_user_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartSeasonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSeasonResponse) ProtoMessage() {}
+
+func (x *StartSeasonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSeasonResponse.ProtoReflect.Descriptor instead.
+func (*StartSeasonResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *StartSeasonResponse) GetSeason() *Season {
+	if x != nil {
+		return x.Season
+	}
+	return nil
+}
+
+func (x *StartSeasonResponse) GetEnded() *Season {
+	if x != nil {
+		return x.Ended
+	}
+	return nil
+}
+
+type GetSeasonStandingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeasonId      int64                  `protobuf:"varint,1,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	TimeControl   TimeControl            `protobuf:"varint,2,opt,name=time_control,json=timeControl,proto3,enum=user.TimeControl" json:"time_control,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	After         *LeaderboardCursor     `protobuf:"bytes,4,opt,name=after,proto3,oneof" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeasonStandingsRequest) Reset() {
+	*x = GetSeasonStandingsRequest{}
+	mi := &file_user_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeasonStandingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeasonStandingsRequest) ProtoMessage() {}
+
+func (x *GetSeasonStandingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeasonStandingsRequest.ProtoReflect.Descriptor instead.
+func (*GetSeasonStandingsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetSeasonStandingsRequest) GetSeasonId() int64 {
+	if x != nil {
+		return x.SeasonId
+	}
+	return 0
+}
+
+func (x *GetSeasonStandingsRequest) GetTimeControl() TimeControl {
+	if x != nil {
+		return x.TimeControl
+	}
+	return TimeControl_TIME_CONTROL_UNSPECIFIED
+}
+
+func (x *GetSeasonStandingsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetSeasonStandingsRequest) GetAfter() *LeaderboardCursor {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+type SeasonStanding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Rating        int32                  `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	Deviation     float64                `protobuf:"fixed64,4,opt,name=deviation,proto3" json:"deviation,omitempty"`
+	GamesPlayed   int32                  `protobuf:"varint,5,opt,name=games_played,json=gamesPlayed,proto3" json:"games_played,omitempty"`
+	Wins          int32                  `protobuf:"varint,6,opt,name=wins,proto3" json:"wins,omitempty"`
+	Losses        int32                  `protobuf:"varint,7,opt,name=losses,proto3" json:"losses,omitempty"`
+	Draws         int32                  `protobuf:"varint,8,opt,name=draws,proto3" json:"draws,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeasonStanding) Reset() {
+	*x = SeasonStanding{}
+	mi := &file_user_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeasonStanding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeasonStanding) ProtoMessage() {}
+
+func (x *SeasonStanding) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeasonStanding.ProtoReflect.Descriptor instead.
+func (*SeasonStanding) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SeasonStanding) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *SeasonStanding) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SeasonStanding) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *SeasonStanding) GetDeviation() float64 {
+	if x != nil {
+		return x.Deviation
+	}
+	return 0
+}
+
+func (x *SeasonStanding) GetGamesPlayed() int32 {
+	if x != nil {
+		return x.GamesPlayed
+	}
+	return 0
+}
+
+func (x *SeasonStanding) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *SeasonStanding) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *SeasonStanding) GetDraws() int32 {
+	if x != nil {
+		return x.Draws
+	}
+	return 0
+}
+
+type GetSeasonStandingsResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Standings []*SeasonStanding      `protobuf:"bytes,1,rep,name=standings,proto3" json:"standings,omitempty"`
+	// Unset when there are no more standings.
+	Next          *LeaderboardCursor `protobuf:"bytes,2,opt,name=next,proto3,oneof" json:"next,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeasonStandingsResponse) Reset() {
+	*x = GetSeasonStandingsResponse{}
+	mi := &file_user_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeasonStandingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeasonStandingsResponse) ProtoMessage() {}
+
+func (x *GetSeasonStandingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeasonStandingsResponse.ProtoReflect.Descriptor instead.
+func (*GetSeasonStandingsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetSeasonStandingsResponse) GetStandings() []*SeasonStanding {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+func (x *GetSeasonStandingsResponse) GetNext() *LeaderboardCursor {
+	if x != nil {
+		return x.Next
+	}
+	return nil
+}
+
+// Record of a user against one opponent, counted from the side of the user.
+type HeadToHeadRecord struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	OpponentId int64                  `protobuf:"varint,1,opt,name=opponent_id,json=opponentId,proto3" json:"opponent_id,omitempty"`
+	// Unspecified when the record sums every time control.
+	TimeControl   TimeControl            `protobuf:"varint,2,opt,name=time_control,json=timeControl,proto3,enum=user.TimeControl" json:"time_control,omitempty"`
+	Wins          int32                  `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty"`
+	Losses        int32                  `protobuf:"varint,4,opt,name=losses,proto3" json:"losses,omitempty"`
+	Draws         int32                  `protobuf:"varint,5,opt,name=draws,proto3" json:"draws,omitempty"`
+	Games         int32                  `protobuf:"varint,6,opt,name=games,proto3" json:"games,omitempty"`
+	LastGameAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_game_at,json=lastGameAt,proto3,oneof" json:"last_game_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeadToHeadRecord) Reset() {
+	*x = HeadToHeadRecord{}
+	mi := &file_user_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeadToHeadRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeadToHeadRecord) ProtoMessage() {}
+
+func (x *HeadToHeadRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeadToHeadRecord.ProtoReflect.Descriptor instead.
+func (*HeadToHeadRecord) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *HeadToHeadRecord) GetOpponentId() int64 {
+	if x != nil {
+		return x.OpponentId
+	}
+	return 0
+}
+
+func (x *HeadToHeadRecord) GetTimeControl() TimeControl {
+	if x != nil {
+		return x.TimeControl
+	}
+	return TimeControl_TIME_CONTROL_UNSPECIFIED
+}
+
+func (x *HeadToHeadRecord) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *HeadToHeadRecord) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *HeadToHeadRecord) GetDraws() int32 {
+	if x != nil {
+		return x.Draws
+	}
+	return 0
+}
+
+func (x *HeadToHeadRecord) GetGames() int32 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
+}
+
+func (x *HeadToHeadRecord) GetLastGameAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastGameAt
+	}
+	return nil
+}
+
+type GetHeadToHeadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OpponentId    int64                  `protobuf:"varint,2,opt,name=opponent_id,json=opponentId,proto3" json:"opponent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHeadToHeadRequest) Reset() {
+	*x = GetHeadToHeadRequest{}
+	mi := &file_user_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHeadToHeadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHeadToHeadRequest) ProtoMessage() {}
+
+func (x *GetHeadToHeadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHeadToHeadRequest.ProtoReflect.Descriptor instead.
+func (*GetHeadToHeadRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetHeadToHeadRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetHeadToHeadRequest) GetOpponentId() int64 {
+	if x != nil {
+		return x.OpponentId
+	}
+	return 0
+}
+
+type GetHeadToHeadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One record per time control the users played in.
+	Records       []*HeadToHeadRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	Total         *HeadToHeadRecord   `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHeadToHeadResponse) Reset() {
+	*x = GetHeadToHeadResponse{}
+	mi := &file_user_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHeadToHeadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHeadToHeadResponse) ProtoMessage() {}
+
+func (x *GetHeadToHeadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHeadToHeadResponse.ProtoReflect.Descriptor instead.
+func (*GetHeadToHeadResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetHeadToHeadResponse) GetRecords() []*HeadToHeadRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *GetHeadToHeadResponse) GetTotal() *HeadToHeadRecord {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+type GetTopOpponentsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Counts the games of a single time control when set.
+	TimeControl *TimeControl `protobuf:"varint,2,opt,name=time_control,json=timeControl,proto3,enum=user.TimeControl,oneof" json:"time_control,omitempty"`
+	// Number of opponents returned, 10 when unset.
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTopOpponentsRequest) Reset() {
+	*x = GetTopOpponentsRequest{}
+	mi := &file_user_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopOpponentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopOpponentsRequest) ProtoMessage() {}
+
+func (x *GetTopOpponentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopOpponentsRequest.ProtoReflect.Descriptor instead.
+func (*GetTopOpponentsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetTopOpponentsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetTopOpponentsRequest) GetTimeControl() TimeControl {
+	if x != nil && x.TimeControl != nil {
+		return *x.TimeControl
+	}
+	return TimeControl_TIME_CONTROL_UNSPECIFIED
+}
+
+func (x *GetTopOpponentsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetTopOpponentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Most played opponents first.
+	Opponents     []*HeadToHeadRecord `protobuf:"bytes,1,rep,name=opponents,proto3" json:"opponents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTopOpponentsResponse) Reset() {
+	*x = GetTopOpponentsResponse{}
+	mi := &file_user_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopOpponentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopOpponentsResponse) ProtoMessage() {}
+
+func (x *GetTopOpponentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopOpponentsResponse.ProtoReflect.Descriptor instead.
+func (*GetTopOpponentsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetTopOpponentsResponse) GetOpponents() []*HeadToHeadRecord {
+	if x != nil {
+		return x.Opponents
+	}
+	return nil
+}
+
+type RecordGameResultRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	GameId      string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	WhiteUserId int64                  `protobuf:"varint,2,opt,name=white_user_id,json=whiteUserId,proto3" json:"white_user_id,omitempty"`
+	BlackUserId int64                  `protobuf:"varint,3,opt,name=black_user_id,json=blackUserId,proto3" json:"black_user_id,omitempty"`
+	TimeControl TimeControl            `protobuf:"varint,4,opt,name=time_control,json=timeControl,proto3,enum=user.TimeControl" json:"time_control,omitempty"`
+	Outcome     GameOutcome            `protobuf:"varint,5,opt,name=outcome,proto3,enum=user.GameOutcome" json:"outcome,omitempty"`
+	// Defaults to the time the result is recorded, must not be in the future.
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=finished_at,json=finishedAt,proto3,oneof" json:"finished_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordGameResultRequest) Reset() {
+	*x = RecordGameResultRequest{}
+	mi := &file_user_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordGameResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordGameResultRequest) ProtoMessage() {}
+
+func (x *RecordGameResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordGameResultRequest.ProtoReflect.Descriptor instead.
+func (*RecordGameResultRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RecordGameResultRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *RecordGameResultRequest) GetWhiteUserId() int64 {
+	if x != nil {
+		return x.WhiteUserId
+	}
+	return 0
+}
+
+func (x *RecordGameResultRequest) GetBlackUserId() int64 {
+	if x != nil {
+		return x.BlackUserId
+	}
+	return 0
+}
+
+func (x *RecordGameResultRequest) GetTimeControl() TimeControl {
+	if x != nil {
+		return x.TimeControl
+	}
+	return TimeControl_TIME_CONTROL_UNSPECIFIED
+}
+
+func (x *RecordGameResultRequest) GetOutcome() GameOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return GameOutcome_GAME_OUTCOME_UNSPECIFIED
+}
+
+func (x *RecordGameResultRequest) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+type RecordGameResultResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	White *Rating                `protobuf:"bytes,1,opt,name=white,proto3" json:"white,omitempty"`
+	Black *Rating                `protobuf:"bytes,2,opt,name=black,proto3" json:"black,omitempty"`
+	// Set when the game had already been recorded and ratings were not changed again.
+	AlreadyRecorded bool `protobuf:"varint,3,opt,name=already_recorded,json=alreadyRecorded,proto3" json:"already_recorded,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RecordGameResultResponse) Reset() {
+	*x = RecordGameResultResponse{}
+	mi := &file_user_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordGameResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordGameResultResponse) ProtoMessage() {}
+
+func (x *RecordGameResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordGameResultResponse.ProtoReflect.Descriptor instead.
+func (*RecordGameResultResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RecordGameResultResponse) GetWhite() *Rating {
+	if x != nil {
+		return x.White
+	}
+	return nil
+}
+
+func (x *RecordGameResultResponse) GetBlack() *Rating {
+	if x != nil {
+		return x.Black
+	}
+	return nil
+}
+
+func (x *RecordGameResultResponse) GetAlreadyRecorded() bool {
+	if x != nil {
+		return x.AlreadyRecorded
+	}
+	return false
+}
+
+type GetLeaderboardRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	TimeControl TimeControl            `protobuf:"varint,1,opt,name=time_control,json=timeControl,proto3,enum=user.TimeControl" json:"time_control,omitempty"`
+	Limit       int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Cursor returned with the previous page; the first page is returned when unset.
+	After         *LeaderboardCursor `protobuf:"bytes,4,opt,name=after,proto3,oneof" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeaderboardRequest) Reset() {
+	*x = GetLeaderboardRequest{}
+	mi := &file_user_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetLeaderboardRequest) GetTimeControl() TimeControl {
+	if x != nil {
+		return x.TimeControl
+	}
+	return TimeControl_TIME_CONTROL_UNSPECIFIED
+}
+
+func (x *GetLeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetLeaderboardRequest) GetAfter() *LeaderboardCursor {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+type LeaderboardCursor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rating        int32                  `protobuf:"varint,1,opt,name=rating,proto3" json:"rating,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaderboardCursor) Reset() {
+	*x = LeaderboardCursor{}
+	mi := &file_user_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardCursor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardCursor) ProtoMessage() {}
+
+func (x *LeaderboardCursor) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardCursor.ProtoReflect.Descriptor instead.
+func (*LeaderboardCursor) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *LeaderboardCursor) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *LeaderboardCursor) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type LeaderboardEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Rating        *Rating                `protobuf:"bytes,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaderboardEntry) Reset() {
+	*x = LeaderboardEntry{}
+	mi := &file_user_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardEntry) ProtoMessage() {}
+
+func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *LeaderboardEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetRating() *Rating {
+	if x != nil {
+		return x.Rating
+	}
+	return nil
+}
+
+type GetLeaderboardResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Entries []*LeaderboardEntry    `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// Unset when there are no more entries.
+	Next          *LeaderboardCursor `protobuf:"bytes,2,opt,name=next,proto3,oneof" json:"next,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeaderboardResponse) Reset() {
+	*x = GetLeaderboardResponse{}
+	mi := &file_user_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetLeaderboardResponse) GetEntries() []*LeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetLeaderboardResponse) GetNext() *LeaderboardCursor {
+	if x != nil {
+		return x.Next
+	}
+	return nil
+}
+
+type GetLeaderboardAroundUserRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	UserId      int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TimeControl TimeControl            `protobuf:"varint,2,opt,name=time_control,json=timeControl,proto3,enum=user.TimeControl" json:"time_control,omitempty"`
+	// Number of entries above and below the user, 5 when unset.
+	Radius        int32 `protobuf:"varint,3,opt,name=radius,proto3" json:"radius,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeaderboardAroundUserRequest) Reset() {
+	*x = GetLeaderboardAroundUserRequest{}
+	mi := &file_user_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaderboardAroundUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardAroundUserRequest) ProtoMessage() {}
+
+func (x *GetLeaderboardAroundUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardAroundUserRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardAroundUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetLeaderboardAroundUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetLeaderboardAroundUserRequest) GetTimeControl() TimeControl {
+	if x != nil {
+		return x.TimeControl
+	}
+	return TimeControl_TIME_CONTROL_UNSPECIFIED
+}
+
+func (x *GetLeaderboardAroundUserRequest) GetRadius() int32 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+type GetLeaderboardAroundUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*LeaderboardEntry    `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeaderboardAroundUserResponse) Reset() {
+	*x = GetLeaderboardAroundUserResponse{}
+	mi := &file_user_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaderboardAroundUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardAroundUserResponse) ProtoMessage() {}
+
+func (x *GetLeaderboardAroundUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardAroundUserResponse.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardAroundUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetLeaderboardAroundUserResponse) GetEntries() []*LeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type GetUserRankRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TimeControl   TimeControl            `protobuf:"varint,2,opt,name=time_control,json=timeControl,proto3,enum=user.TimeControl" json:"time_control,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRankRequest) Reset() {
+	*x = GetUserRankRequest{}
+	mi := &file_user_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRankRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRankRequest) ProtoMessage() {}
+
+func (x *GetUserRankRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRankRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRankRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetUserRankRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUserRankRequest) GetTimeControl() TimeControl {
+	if x != nil {
+		return x.TimeControl
+	}
+	return TimeControl_TIME_CONTROL_UNSPECIFIED
+}
+
+type GetUserRankResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRankResponse) Reset() {
+	*x = GetUserRankResponse{}
+	mi := &file_user_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRankResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRankResponse) ProtoMessage() {}
+
+func (x *GetUserRankResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRankResponse.ProtoReflect.Descriptor instead.
+func (*GetUserRankResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetUserRankResponse) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+type SuspendUserRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Moderator taking the action.
+	ActorId int64  `protobuf:"varint,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Types that are valid to be assigned to End:
+	//
+	//	*SuspendUserRequest_Until
+	//	*SuspendUserRequest_Duration
+	End           isSuspendUserRequest_End `protobuf_oneof:"end"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendUserRequest) Reset() {
+	*x = SuspendUserRequest{}
+	mi := &file_user_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendUserRequest) ProtoMessage() {}
+
+func (x *SuspendUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendUserRequest.ProtoReflect.Descriptor instead.
+func (*SuspendUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SuspendUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SuspendUserRequest) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *SuspendUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SuspendUserRequest) GetEnd() isSuspendUserRequest_End {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *SuspendUserRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		if x, ok := x.End.(*SuspendUserRequest_Until); ok {
+			return x.Until
+		}
+	}
+	return nil
+}
+
+func (x *SuspendUserRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		if x, ok := x.End.(*SuspendUserRequest_Duration); ok {
+			return x.Duration
+		}
+	}
+	return nil
+}
+
+type isSuspendUserRequest_End interface {
+	isSuspendUserRequest_End()
+}
+
+type SuspendUserRequest_Until struct {
+	Until *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=until,proto3,oneof"`
+}
+
+type SuspendUserRequest_Duration struct {
+	Duration *durationpb.Duration `protobuf:"bytes,5,opt,name=duration,proto3,oneof"`
+}
+
+func (*SuspendUserRequest_Until) isSuspendUserRequest_End() {}
+
+func (*SuspendUserRequest_Duration) isSuspendUserRequest_End() {}
+
+type BanUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ActorId       int64                  `protobuf:"varint,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BanUserRequest) Reset() {
+	*x = BanUserRequest{}
+	mi := &file_user_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanUserRequest) ProtoMessage() {}
+
+func (x *BanUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanUserRequest.ProtoReflect.Descriptor instead.
+func (*BanUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *BanUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BanUserRequest) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *BanUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReinstateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ActorId       int64                  `protobuf:"varint,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReinstateUserRequest) Reset() {
+	*x = ReinstateUserRequest{}
+	mi := &file_user_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReinstateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReinstateUserRequest) ProtoMessage() {}
+
+func (x *ReinstateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReinstateUserRequest.ProtoReflect.Descriptor instead.
+func (*ReinstateUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ReinstateUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReinstateUserRequest) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *ReinstateUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ModerateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateUserResponse) Reset() {
+	*x = ModerateUserResponse{}
+	mi := &file_user_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateUserResponse) ProtoMessage() {}
+
+func (x *ModerateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateUserResponse.ProtoReflect.Descriptor instead.
+func (*ModerateUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ModerateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type ModerationRecord struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Action ModerationAction       `protobuf:"varint,3,opt,name=action,proto3,enum=user.ModerationAction" json:"action,omitempty"`
+	// Unset when the action was taken automatically, e.g. when a suspension expired.
+	ActorId        *int64                 `protobuf:"varint,4,opt,name=actor_id,json=actorId,proto3,oneof" json:"actor_id,omitempty"`
+	Reason         string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	SuspendedUntil *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=suspended_until,json=suspendedUntil,proto3,oneof" json:"suspended_until,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ModerationRecord) Reset() {
+	*x = ModerationRecord{}
+	mi := &file_user_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerationRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationRecord) ProtoMessage() {}
+
+func (x *ModerationRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationRecord.ProtoReflect.Descriptor instead.
+func (*ModerationRecord) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ModerationRecord) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ModerationRecord) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ModerationRecord) GetAction() ModerationAction {
+	if x != nil {
+		return x.Action
+	}
+	return ModerationAction_MODERATION_ACTION_UNSPECIFIED
+}
+
+func (x *ModerationRecord) GetActorId() int64 {
+	if x != nil && x.ActorId != nil {
+		return *x.ActorId
+	}
+	return 0
+}
+
+func (x *ModerationRecord) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ModerationRecord) GetSuspendedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SuspendedUntil
+	}
+	return nil
+}
+
+func (x *ModerationRecord) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetModerationLogRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Number of records, newest first; 20 when unset.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModerationLogRequest) Reset() {
+	*x = GetModerationLogRequest{}
+	mi := &file_user_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModerationLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModerationLogRequest) ProtoMessage() {}
+
+func (x *GetModerationLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModerationLogRequest.ProtoReflect.Descriptor instead.
+func (*GetModerationLogRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetModerationLogRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetModerationLogRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetModerationLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*ModerationRecord    `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModerationLogResponse) Reset() {
+	*x = GetModerationLogResponse{}
+	mi := &file_user_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModerationLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModerationLogResponse) ProtoMessage() {}
+
+func (x *GetModerationLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModerationLogResponse.ProtoReflect.Descriptor instead.
+func (*GetModerationLogResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetModerationLogResponse) GetRecords() []*ModerationRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type GrantPremiumRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Added to the running premium period, or counted from now when the user is not premium.
+	Duration      *durationpb.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantPremiumRequest) Reset() {
+	*x = GrantPremiumRequest{}
+	mi := &file_user_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantPremiumRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantPremiumRequest) ProtoMessage() {}
+
+func (x *GrantPremiumRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantPremiumRequest.ProtoReflect.Descriptor instead.
+func (*GrantPremiumRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GrantPremiumRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GrantPremiumRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+type RevokePremiumRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokePremiumRequest) Reset() {
+	*x = RevokePremiumRequest{}
+	mi := &file_user_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokePremiumRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokePremiumRequest) ProtoMessage() {}
+
+func (x *RevokePremiumRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokePremiumRequest.ProtoReflect.Descriptor instead.
+func (*RevokePremiumRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *RevokePremiumRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type PremiumResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PremiumResponse) Reset() {
+	*x = PremiumResponse{}
+	mi := &file_user_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PremiumResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PremiumResponse) ProtoMessage() {}
+
+func (x *PremiumResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PremiumResponse.ProtoReflect.Descriptor instead.
+func (*PremiumResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *PremiumResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type GetPremiumStatusRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Defaults to now. Instants in the past are rejected, only the current premium period is known.
+	At            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3,oneof" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPremiumStatusRequest) Reset() {
+	*x = GetPremiumStatusRequest{}
+	mi := &file_user_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPremiumStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPremiumStatusRequest) ProtoMessage() {}
+
+func (x *GetPremiumStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPremiumStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetPremiumStatusRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetPremiumStatusRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetPremiumStatusRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type GetPremiumStatusResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	IsPremium bool                   `protobuf:"varint,1,opt,name=is_premium,json=isPremium,proto3" json:"is_premium,omitempty"`
+	// Unset for premium without an end.
+	PremiumUntil  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=premium_until,json=premiumUntil,proto3,oneof" json:"premium_until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPremiumStatusResponse) Reset() {
+	*x = GetPremiumStatusResponse{}
+	mi := &file_user_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPremiumStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPremiumStatusResponse) ProtoMessage() {}
+
+func (x *GetPremiumStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPremiumStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetPremiumStatusResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetPremiumStatusResponse) GetIsPremium() bool {
+	if x != nil {
+		return x.IsPremium
+	}
+	return false
+}
+
+func (x *GetPremiumStatusResponse) GetPremiumUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PremiumUntil
+	}
+	return nil
+}
+
+var File_user_proto protoreflect.FileDescriptor
+
+const file_user_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"user.proto\x12\x04user\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\"\xbb\x06\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x10\n" +
+	"\x03tag\x18\x02 \x01(\tR\x03tag\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12(\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x10.user.UserStatusR\x06status\x12\x1f\n" +
+	"\vis_verified\x18\x05 \x01(\bR\n" +
+	"isVerified\x12\x1d\n" +
+	"\n" +
+	"is_premium\x18\x06 \x01(\bR\tisPremium\x12K\n" +
+	"\x11email_verified_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x0femailVerifiedAt\x88\x01\x01\x12D\n" +
+	"\rpremium_until\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x01R\fpremiumUntil\x88\x01\x01\x12\x1a\n" +
+	"\blanguage\x18\t \x01(\tR\blanguage\x12@\n" +
+	"\x0elast_active_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\flastActiveAt\x12C\n" +
+	"\rlast_login_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampH\x02R\vlastLoginAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12H\n" +
+	"\x0fsuspended_until\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampH\x03R\x0esuspendedUntil\x88\x01\x01\x12>\n" +
+	"\n" +
+	"deleted_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampH\x04R\tdeletedAt\x88\x01\x01B\x14\n" +
+	"\x12_email_verified_atB\x10\n" +
+	"\x0e_premium_untilB\x10\n" +
+	"\x0e_last_login_atB\x12\n" +
+	"\x10_suspended_untilB\r\n" +
+	"\v_deleted_at\"\xfb\x05\n" +
+	"\aProfile\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vpublic_name\x18\x02 \x01(\tR\n" +
+	"publicName\x12\x15\n" +
+	"\x03bio\x18\x03 \x01(\tH\x00R\x03bio\x88\x01\x01\x12&\n" +
+	"\fcountry_code\x18\x04 \x01(\tH\x01R\vcountryCode\x88\x01\x01\x12\x17\n" +
+	"\x04city\x18\x05 \x01(\tH\x02R\x04city\x88\x01\x01\x12>\n" +
+	"\n" +
+	"birth_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\tbirthDate\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"avatar_url\x18\a \x01(\tH\x04R\tavatarUrl\x88\x01\x01\x12+\n" +
+	"\x0fcover_image_url\x18\b \x01(\tH\x05R\rcoverImageUrl\x88\x01\x01\x12\x1b\n" +
+	"\tis_public\x18\t \x01(\bR\bisPublic\x12!\n" +
+	"\fshow_country\x18\n" +
+	" \x01(\bR\vshowCountry\x12$\n" +
+	"\vwebsite_url\x18\v \x01(\tH\x06R\n" +
+	"websiteUrl\x88\x01\x01\x12,\n" +
+	"\x0ftwitch_username\x18\f \x01(\tH\aR\x0etwitchUsername\x88\x01\x01\x123\n" +
+	"\x13youtube_channel_url\x18\r \x01(\tH\bR\x11youtubeChannelUrl\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x06\n" +
+	"\x04_bioB\x0f\n" +
+	"\r_country_codeB\a\n" +
+	"\x05_cityB\r\n" +
+	"\v_birth_dateB\r\n" +
+	"\v_avatar_urlB\x12\n" +
+	"\x10_cover_image_urlB\x0e\n" +
+	"\f_website_urlB\x12\n" +
+	"\x10_twitch_usernameB\x16\n" +
+	"\x14_youtube_channel_url\"\xd4\x04\n" +
+	"\x06Rating\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x124\n" +
+	"\ftime_control\x18\x03 \x01(\x0e2\x11.user.TimeControlR\vtimeControl\x12\x16\n" +
+	"\x06rating\x18\x04 \x01(\x05R\x06rating\x12\x1f\n" +
+	"\vpeak_rating\x18\x05 \x01(\x05R\n" +
+	"peakRating\x12#\n" +
+	"\rlowest_rating\x18\x06 \x01(\x05R\flowestRating\x12!\n" +
+	"\fgames_played\x18\a \x01(\x05R\vgamesPlayed\x12\x12\n" +
+	"\x04wins\x18\b \x01(\x05R\x04wins\x12\x16\n" +
+	"\x06losses\x18\t \x01(\x05R\x06losses\x12\x14\n" +
+	"\x05draws\x18\n" +
+	" \x01(\x05R\x05draws\x12A\n" +
+	"\flast_game_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"lastGameAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1c\n" +
+	"\tdeviation\x18\x0e \x01(\x01R\tdeviation\x12\x1e\n" +
+	"\n" +
+	"volatility\x18\x0f \x01(\x01R\n" +
+	"volatility\x12 \n" +
+	"\vprovisional\x18\x10 \x01(\bR\vprovisionalB\x0f\n" +
+	"\r_last_game_at\"\xae\x03\n" +
+	"\rRatingHistory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x124\n" +
+	"\ftime_control\x18\x03 \x01(\x0e2\x11.user.TimeControlR\vtimeControl\x12\x1d\n" +
+	"\n" +
+	"old_rating\x18\x04 \x01(\x05R\toldRating\x12\x1d\n" +
+	"\n" +
+	"new_rating\x18\x05 \x01(\x05R\tnewRating\x12!\n" +
+	"\frating_range\x18\x06 \x01(\x05R\vratingRange\x12\x1c\n" +
+	"\agame_id\x18\a \x01(\tH\x00R\x06gameId\x88\x01\x01\x127\n" +
+	"\rchange_reason\x18\b \x01(\x0e2\x12.user.ChangeReasonR\fchangeReason\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1e\n" +
+	"\bactor_id\x18\n" +
+	" \x01(\x03H\x01R\aactorId\x88\x01\x01\x12\x12\n" +
+	"\x04note\x18\v \x01(\tR\x04noteB\n" +
+	"\n" +
+	"\b_game_idB\v\n" +
+	"\t_actor_id\"\xa7\x01\n" +
+	"\x13RegisterUserRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1f\n" +
+	"\vpublic_name\x18\x02 \x01(\tR\n" +
+	"publicName\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12!\n" +
+	"\fcountry_code\x18\x04 \x01(\tR\vcountryCode\x12\x1a\n" +
+	"\blanguage\x18\x05 \x01(\tR\blanguage\"0\n" +
+	"\x14RegisterUserResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"k\n" +
+	"\x18VerifyCredentialsRequest\x12\x16\n" +
+	"\x05email\x18\x01 \x01(\tH\x00R\x05email\x12\x12\n" +
+	"\x03tag\x18\x02 \x01(\tH\x00R\x03tag\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpasswordB\a\n" +
+	"\x05login\";\n" +
+	"\x19VerifyCredentialsResponse\x12\x1e\n" +
+	"\x04user\x18\x01 \x01(\v2\n" +
+	".user.UserR\x04user\"7\n" +
+	"\x1cSendEmailVerificationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"9\n" +
+	"\x1dSendEmailVerificationResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"+\n" +
+	"\x13ConfirmEmailRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"6\n" +
+	"\x14ConfirmEmailResponse\x12\x1e\n" +
+	"\x04user\x18\x01 \x01(\v2\n" +
+	".user.UserR\x04user\"3\n" +
+	"\x1bRequestPasswordResetRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"8\n" +
+	"\x1cRequestPasswordResetResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"V\n" +
+	"\x1bConfirmPasswordResetRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12!\n" +
+	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\"8\n" +
+	"\x1cConfirmPasswordResetResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"v\n" +
+	"\x15ChangePasswordRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12!\n" +
+	"\fold_password\x18\x02 \x01(\tR\voldPassword\x12!\n" +
+	"\fnew_password\x18\x03 \x01(\tR\vnewPassword\"2\n" +
+	"\x16ChangePasswordResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\" \n" +
+	"\x0eGetUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"'\n" +
+	"\x13GetUserByTagRequest\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\"s\n" +
+	"\x0fGetUserResponse\x12\x1e\n" +
+	"\x04user\x18\x01 \x01(\v2\n" +
+	".user.UserR\x04user\x12,\n" +
+	"\x0fredirected_from\x18\x02 \x01(\tH\x00R\x0eredirectedFrom\x88\x01\x01B\x12\n" +
+	"\x10_redirected_from\"v\n" +
+	"\x11UpdateUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\x05email\x18\x02 \x01(\tH\x00R\x05email\x88\x01\x01\x12\x1f\n" +
+	"\blanguage\x18\x03 \x01(\tH\x01R\blanguage\x88\x01\x01B\b\n" +
+	"\x06_emailB\v\n" +
+	"\t_language\"4\n" +
+	"\x12UpdateUserResponse\x12\x1e\n" +
+	"\x04user\x18\x01 \x01(\v2\n" +
+	".user.UserR\x04user\"=\n" +
+	"\x10ChangeTagRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x10\n" +
+	"\x03tag\x18\x02 \x01(\tR\x03tag\"3\n" +
+	"\x11ChangeTagResponse\x12\x1e\n" +
+	"\x04user\x18\x01 \x01(\v2\n" +
+	".user.UserR\x04user\"#\n" +
+	"\x11DeleteUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x82\x01\n" +
+	"\x12DeleteUserResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12A\n" +
+	"\fgrace_period\x18\x02 \x01(\v2\x19.google.protobuf.DurationH\x00R\vgracePeriod\x88\x01\x01B\x0f\n" +
+	"\r_grace_period\"$\n" +
+	"\x12RestoreUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"5\n" +
+	"\x13RestoreUserResponse\x12\x1e\n" +
+	"\x04user\x18\x01 \x01(\v2\n" +
+	".user.UserR\x04user\"0\n" +
+	"\x15ExportUserDataRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\")\n" +
+	"\x13ExportUserDataChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\",\n" +
+	"\x11GetProfileRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"=\n" +
+	"\x12GetProfileResponse\x12'\n" +
+	"\aprofile\x18\x01 \x01(\v2\r.user.ProfileR\aprofile\"\xd0\x05\n" +
+	"\x14UpdateProfileRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12$\n" +
+	"\vpublic_name\x18\x02 \x01(\tH\x00R\n" +
+	"publicName\x88\x01\x01\x12\x15\n" +
+	"\x03bio\x18\x03 \x01(\tH\x01R\x03bio\x88\x01\x01\x12&\n" +
+	"\fcountry_code\x18\x04 \x01(\tH\x02R\vcountryCode\x88\x01\x01\x12\x17\n" +
+	"\x04city\x18\x05 \x01(\tH\x03R\x04city\x88\x01\x01\x12>\n" +
+	"\n" +
+	"birth_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x04R\tbirthDate\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"avatar_url\x18\a \x01(\tH\x05R\tavatarUrl\x88\x01\x01\x12+\n" +
+	"\x0fcover_image_url\x18\b \x01(\tH\x06R\rcoverImageUrl\x88\x01\x01\x12 \n" +
+	"\tis_public\x18\t \x01(\bH\aR\bisPublic\x88\x01\x01\x12&\n" +
+	"\fshow_country\x18\n" +
+	" \x01(\bH\bR\vshowCountry\x88\x01\x01\x12$\n" +
+	"\vwebsite_url\x18\v \x01(\tH\tR\n" +
+	"websiteUrl\x88\x01\x01\x12,\n" +
+	"\x0ftwitch_username\x18\f \x01(\tH\n" +
+	"R\x0etwitchUsername\x88\x01\x01\x123\n" +
+	"\x13youtube_channel_url\x18\r \x01(\tH\vR\x11youtubeChannelUrl\x88\x01\x01B\x0e\n" +
+	"\f_public_nameB\x06\n" +
+	"\x04_bioB\x0f\n" +
+	"\r_country_codeB\a\n" +
+	"\x05_cityB\r\n" +
+	"\v_birth_dateB\r\n" +
+	"\v_avatar_urlB\x12\n" +
+	"\x10_cover_image_urlB\f\n" +
+	"\n" +
+	"_is_publicB\x0f\n" +
+	"\r_show_countryB\x0e\n" +
+	"\f_website_urlB\x12\n" +
+	"\x10_twitch_usernameB\x16\n" +
+	"\x14_youtube_channel_url\"@\n" +
+	"\x15UpdateProfileResponse\x12'\n" +
+	"\aprofile\x18\x01 \x01(\v2\r.user.ProfileR\aprofile\"5\n" +
+	"\x18GetPublicProfilesRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\x03R\auserIds\"F\n" +
+	"\x19GetPublicProfilesResponse\x12)\n" +
+	"\bprofiles\x18\x01 \x03(\v2\r.user.ProfileR\bprofiles\"\x9d\x01\n" +
+	"\x11GetRatingsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x129\n" +
+	"\ftime_control\x18\x02 \x01(\x0e2\x11.user.TimeControlH\x00R\vtimeControl\x88\x01\x01\x12#\n" +
+	"\rhistory_limit\x18\x03 \x01(\x05R\fhistoryLimitB\x0f\n" +
+	"\r_time_control\"k\n" +
+	"\x12GetRatingsResponse\x12&\n" +
+	"\aratings\x18\x01 \x03(\v2\f.user.RatingR\aratings\x12-\n" +
+	"\ahistory\x18\x02 \x03(\v2\x13.user.RatingHistoryR\ahistory\"\x8d\x02\n" +
+	"\x15GetRatingChartRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x124\n" +
+	"\ftime_control\x18\x02 \x01(\x0e2\x11.user.TimeControlR\vtimeControl\x12/\n" +
+	"\binterval\x18\x03 \x01(\x0e2\x13.user.ChartIntervalR\binterval\x123\n" +
+	"\x04from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x04from\x88\x01\x01\x12/\n" +
+	"\x02to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x02to\x88\x01\x01B\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_to\"\xa4\x01\n" +
+	"\fRatingBucket\x120\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12\x12\n" +
+	"\x04open\x18\x02 \x01(\x05R\x04open\x12\x14\n" +
+	"\x05close\x18\x03 \x01(\x05R\x05close\x12\x10\n" +
+	"\x03min\x18\x04 \x01(\x05R\x03min\x12\x10\n" +
+	"\x03max\x18\x05 \x01(\x05R\x03max\x12\x14\n" +
+	"\x05games\x18\x06 \x01(\x05R\x05games\"w\n" +
+	"\x16GetRatingChartResponse\x12/\n" +
+	"\binterval\x18\x01 \x01(\x0e2\x13.user.ChartIntervalR\binterval\x12,\n" +
+	"\abuckets\x18\x02 \x03(\v2\x12.user.RatingBucketR\abuckets\"\xcd\x01\n" +
+	"\x13AdjustRatingRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x124\n" +
+	"\ftime_control\x18\x02 \x01(\x0e2\x11.user.TimeControlR\vtimeControl\x12\x16\n" +
+	"\x05delta\x18\x03 \x01(\x05H\x00R\x05delta\x12\x16\n" +
+	"\x05value\x18\x04 \x01(\x05H\x00R\x05value\x12\x19\n" +
+	"\bactor_id\x18\x05 \x01(\x03R\aactorId\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04noteB\b\n" +
+	"\x06change\"\xad\x01\n" +
+	"\x15PenalizeRatingRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x124\n" +
+	"\ftime_control\x18\x02 \x01(\x0e2\x11.user.TimeControlR\vtimeControl\x12\x16\n" +
+	"\x06points\x18\x03 \x01(\x05R\x06points\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\x03R\aactorId\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\"k\n" +
+	"\x14AdjustRatingResponse\x12$\n" +
+	"\x06rating\x18\x01 \x01(\v2\f.user.RatingR\x06rating\x12-\n" +
+	"\ahistory\x18\x02 \x01(\v2\x13.user.RatingHistoryR\ahistory\"\x81\x01\n" +
+	"\x16RefundOpponentsRequest\x12\x1d\n" +
+	"\n" +
+	"cheater_id\x18\x01 \x01(\x03R\tcheaterId\x12\x19\n" +
+	"\bgame_ids\x18\x02 \x03(\tR\agameIds\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\x03R\aactorId\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\"r\n" +
+	"\x17RefundOpponentsResponse\x12-\n" +
+	"\arefunds\x18\x01 \x03(\v2\x13.user.RatingHistoryR\arefunds\x12(\n" +
+	"\x10skipped_game_ids\x18\x02 \x03(\tR\x0eskippedGameIds\"\xb0\x01\n" +
+	"\x06Season\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"started_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12:\n" +
+	"\bended_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\aendedAt\x88\x01\x01B\v\n" +
+	"\t_ended_at\"(\n" +
+	"\x12StartSeasonRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"n\n" +
+	"\x13StartSeasonResponse\x12$\n" +
+	"\x06season\x18\x01 \x01(\v2\f.user.SeasonR\x06season\x12'\n" +
+	"\x05ended\x18\x02 \x01(\v2\f.user.SeasonH\x00R\x05ended\x88\x01\x01B\b\n" +
+	"\x06_ended\"\xc2\x01\n" +
+	"\x19GetSeasonStandingsRequest\x12\x1b\n" +
+	"\tseason_id\x18\x01 \x01(\x03R\bseasonId\x124\n" +
+	"\ftime_control\x18\x02 \x01(\x0e2\x11.user.TimeControlR\vtimeControl\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x122\n" +
+	"\x05after\x18\x04 \x01(\v2\x17.user.LeaderboardCursorH\x00R\x05after\x88\x01\x01B\b\n" +
+	"\x06_after\"\xd8\x01\n" +
+	"\x0eSeasonStanding\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\x05R\x06rating\x12\x1c\n" +
+	"\tdeviation\x18\x04 \x01(\x01R\tdeviation\x12!\n" +
+	"\fgames_played\x18\x05 \x01(\x05R\vgamesPlayed\x12\x12\n" +
+	"\x04wins\x18\x06 \x01(\x05R\x04wins\x12\x16\n" +
+	"\x06losses\x18\a \x01(\x05R\x06losses\x12\x14\n" +
+	"\x05draws\x18\b \x01(\x05R\x05draws\"\x8b\x01\n" +
+	"\x1aGetSeasonStandingsResponse\x122\n" +
+	"\tstandings\x18\x01 \x03(\v2\x14.user.SeasonStandingR\tstandings\x120\n" +
+	"\x04next\x18\x02 \x01(\v2\x17.user.LeaderboardCursorH\x00R\x04next\x88\x01\x01B\a\n" +
+	"\x05_next\"\x95\x02\n" +
+	"\x10HeadToHeadRecord\x12\x1f\n" +
+	"\vopponent_id\x18\x01 \x01(\x03R\n" +
+	"opponentId\x124\n" +
+	"\ftime_control\x18\x02 \x01(\x0e2\x11.user.TimeControlR\vtimeControl\x12\x12\n" +
+	"\x04wins\x18\x03 \x01(\x05R\x04wins\x12\x16\n" +
+	"\x06losses\x18\x04 \x01(\x05R\x06losses\x12\x14\n" +
+	"\x05draws\x18\x05 \x01(\x05R\x05draws\x12\x14\n" +
+	"\x05games\x18\x06 \x01(\x05R\x05games\x12A\n" +
+	"\flast_game_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"lastGameAt\x88\x01\x01B\x0f\n" +
+	"\r_last_game_at\"P\n" +
+	"\x14GetHeadToHeadRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vopponent_id\x18\x02 \x01(\x03R\n" +
+	"opponentId\"w\n" +
+	"\x15GetHeadToHeadResponse\x120\n" +
+	"\arecords\x18\x01 \x03(\v2\x16.user.HeadToHeadRecordR\arecords\x12,\n" +
+	"\x05total\x18\x02 \x01(\v2\x16.user.HeadToHeadRecordR\x05total\"\x93\x01\n" +
+	"\x16GetTopOpponentsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x129\n" +
+	"\ftime_control\x18\x02 \x01(\x0e2\x11.user.TimeControlH\x00R\vtimeControl\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limitB\x0f\n" +
+	"\r_time_control\"O\n" +
+	"\x17GetTopOpponentsResponse\x124\n" +
+	"\topponents\x18\x01 \x03(\v2\x16.user.HeadToHeadRecordR\topponents\"\xaf\x02\n" +
+	"\x17RecordGameResultRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\"\n" +
+	"\rwhite_user_id\x18\x02 \x01(\x03R\vwhiteUserId\x12\"\n" +
+	"\rblack_user_id\x18\x03 \x01(\x03R\vblackUserId\x124\n" +
+	"\ftime_control\x18\x04 \x01(\x0e2\x11.user.TimeControlR\vtimeControl\x12+\n" +
+	"\aoutcome\x18\x05 \x01(\x0e2\x11.user.GameOutcomeR\aoutcome\x12@\n" +
+	"\vfinished_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"finishedAt\x88\x01\x01B\x0e\n" +
+	"\f_finished_at\"\x8d\x01\n" +
+	"\x18RecordGameResultResponse\x12\"\n" +
+	"\x05white\x18\x01 \x01(\v2\f.user.RatingR\x05white\x12\"\n" +
+	"\x05black\x18\x02 \x01(\v2\f.user.RatingR\x05black\x12)\n" +
+	"\x10already_recorded\x18\x03 \x01(\bR\x0falreadyRecorded\"\xaf\x01\n" +
+	"\x15GetLeaderboardRequest\x124\n" +
+	"\ftime_control\x18\x01 \x01(\x0e2\x11.user.TimeControlR\vtimeControl\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x122\n" +
+	"\x05after\x18\x04 \x01(\v2\x17.user.LeaderboardCursorH\x00R\x05after\x88\x01\x01B\b\n" +
+	"\x06_afterJ\x04\b\x03\x10\x04R\x06offset\"D\n" +
+	"\x11LeaderboardCursor\x12\x16\n" +
+	"\x06rating\x18\x01 \x01(\x05R\x06rating\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"L\n" +
+	"\x10LeaderboardEntry\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12$\n" +
+	"\x06rating\x18\x02 \x01(\v2\f.user.RatingR\x06rating\"\x85\x01\n" +
+	"\x16GetLeaderboardResponse\x120\n" +
+	"\aentries\x18\x01 \x03(\v2\x16.user.LeaderboardEntryR\aentries\x120\n" +
+	"\x04next\x18\x02 \x01(\v2\x17.user.LeaderboardCursorH\x00R\x04next\x88\x01\x01B\a\n" +
+	"\x05_next\"\x88\x01\n" +
+	"\x1fGetLeaderboardAroundUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x124\n" +
+	"\ftime_control\x18\x02 \x01(\x0e2\x11.user.TimeControlR\vtimeControl\x12\x16\n" +
+	"\x06radius\x18\x03 \x01(\x05R\x06radius\"T\n" +
+	" GetLeaderboardAroundUserResponse\x120\n" +
+	"\aentries\x18\x01 \x03(\v2\x16.user.LeaderboardEntryR\aentries\"c\n" +
+	"\x12GetUserRankRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x124\n" +
+	"\ftime_control\x18\x02 \x01(\x0e2\x11.user.TimeControlR\vtimeControl\")\n" +
+	"\x13GetUserRankResponse\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\"\xd4\x01\n" +
+	"\x12SuspendUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\x03R\aactorId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x122\n" +
+	"\x05until\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x05until\x127\n" +
+	"\bduration\x18\x05 \x01(\v2\x19.google.protobuf.DurationH\x00R\bdurationB\x05\n" +
+	"\x03end\"\\\n" +
+	"\x0eBanUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\x03R\aactorId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"b\n" +
+	"\x14ReinstateUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\x03R\aactorId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"6\n" +
+	"\x14ModerateUserResponse\x12\x1e\n" +
+	"\x04user\x18\x01 \x01(\v2\n" +
+	".user.UserR\x04user\"\xc9\x02\n" +
+	"\x10ModerationRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12.\n" +
+	"\x06action\x18\x03 \x01(\x0e2\x16.user.ModerationActionR\x06action\x12\x1e\n" +
+	"\bactor_id\x18\x04 \x01(\x03H\x00R\aactorId\x88\x01\x01\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12H\n" +
+	"\x0fsuspended_until\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x0esuspendedUntil\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\v\n" +
+	"\t_actor_idB\x12\n" +
+	"\x10_suspended_until\"H\n" +
+	"\x17GetModerationLogRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"L\n" +
+	"\x18GetModerationLogResponse\x120\n" +
+	"\arecords\x18\x01 \x03(\v2\x16.user.ModerationRecordR\arecords\"e\n" +
+	"\x13GrantPremiumRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x125\n" +
+	"\bduration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bduration\"/\n" +
+	"\x14RevokePremiumRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"1\n" +
+	"\x0fPremiumResponse\x12\x1e\n" +
+	"\x04user\x18\x01 \x01(\v2\n" +
+	".user.UserR\x04user\"j\n" +
+	"\x17GetPremiumStatusRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12/\n" +
+	"\x02at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x02at\x88\x01\x01B\x05\n" +
+	"\x03_at\"\x91\x01\n" +
+	"\x18GetPremiumStatusResponse\x12\x1d\n" +
+	"\n" +
+	"is_premium\x18\x01 \x01(\bR\tisPremium\x12D\n" +
+	"\rpremium_until\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\fpremiumUntil\x88\x01\x01B\x10\n" +
+	"\x0e_premium_until*\x8d\x01\n" +
+	"\n" +
+	"UserStatus\x12\x1b\n" +
+	"\x17USER_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12USER_STATUS_ACTIVE\x10\x01\x12\x19\n" +
+	"\x15USER_STATUS_SUSPENDED\x10\x02\x12\x16\n" +
+	"\x12USER_STATUS_BANNED\x10\x03\x12\x17\n" +
+	"\x13USER_STATUS_DELETED\x10\x04*\x90\x01\n" +
+	"\x10ModerationAction\x12!\n" +
+	"\x1dMODERATION_ACTION_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19MODERATION_ACTION_SUSPEND\x10\x01\x12\x19\n" +
+	"\x15MODERATION_ACTION_BAN\x10\x02\x12\x1f\n" +
+	"\x1bMODERATION_ACTION_REINSTATE\x10\x03*\xb1\x01\n" +
+	"\vTimeControl\x12\x1c\n" +
+	"\x18TIME_CONTROL_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13TIME_CONTROL_BULLET\x10\x01\x12\x16\n" +
+	"\x12TIME_CONTROL_BLITZ\x10\x02\x12\x16\n" +
+	"\x12TIME_CONTROL_RAPID\x10\x03\x12\x1a\n" +
+	"\x16TIME_CONTROL_CLASSICAL\x10\x04\x12\x1f\n" +
+	"\x1bTIME_CONTROL_CORRESPONDENCE\x10\x05*\xb8\x01\n" +
+	"\fChangeReason\x12\x1d\n" +
+	"\x19CHANGE_REASON_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12CHANGE_REASON_GAME\x10\x01\x12\x1c\n" +
+	"\x18CHANGE_REASON_ADJUSTMENT\x10\x02\x12\x1e\n" +
+	"\x1aCHANGE_REASON_SEASON_RESET\x10\x03\x12\x19\n" +
+	"\x15CHANGE_REASON_PENALTY\x10\x04\x12\x18\n" +
+	"\x14CHANGE_REASON_REFUND\x10\x05*z\n" +
+	"\rChartInterval\x12\x1e\n" +
+	"\x1aCHART_INTERVAL_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12CHART_INTERVAL_DAY\x10\x01\x12\x17\n" +
+	"\x13CHART_INTERVAL_WEEK\x10\x02\x12\x18\n" +
+	"\x14CHART_INTERVAL_MONTH\x10\x03*|\n" +
+	"\vGameOutcome\x12\x1c\n" +
+	"\x18GAME_OUTCOME_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17GAME_OUTCOME_WHITE_WINS\x10\x01\x12\x1b\n" +
+	"\x17GAME_OUTCOME_BLACK_WINS\x10\x02\x12\x15\n" +
+	"\x11GAME_OUTCOME_DRAW\x10\x032\xf6\x15\n" +
+	"\vUserService\x12E\n" +
+	"\fRegisterUser\x12\x19.user.RegisterUserRequest\x1a\x1a.user.RegisterUserResponse\x12T\n" +
+	"\x11VerifyCredentials\x12\x1e.user.VerifyCredentialsRequest\x1a\x1f.user.VerifyCredentialsResponse\x12`\n" +
+	"\x15SendEmailVerification\x12\".user.SendEmailVerificationRequest\x1a#.user.SendEmailVerificationResponse\x12E\n" +
+	"\fConfirmEmail\x12\x19.user.ConfirmEmailRequest\x1a\x1a.user.ConfirmEmailResponse\x12]\n" +
+	"\x14RequestPasswordReset\x12!.user.RequestPasswordResetRequest\x1a\".user.RequestPasswordResetResponse\x12]\n" +
+	"\x14ConfirmPasswordReset\x12!.user.ConfirmPasswordResetRequest\x1a\".user.ConfirmPasswordResetResponse\x12K\n" +
+	"\x0eChangePassword\x12\x1b.user.ChangePasswordRequest\x1a\x1c.user.ChangePasswordResponse\x126\n" +
+	"\aGetUser\x12\x14.user.GetUserRequest\x1a\x15.user.GetUserResponse\x12@\n" +
+	"\fGetUserByTag\x12\x19.user.GetUserByTagRequest\x1a\x15.user.GetUserResponse\x12?\n" +
+	"\n" +
+	"UpdateUser\x12\x17.user.UpdateUserRequest\x1a\x18.user.UpdateUserResponse\x12<\n" +
+	"\tChangeTag\x12\x16.user.ChangeTagRequest\x1a\x17.user.ChangeTagResponse\x12?\n" +
+	"\n" +
+	"DeleteUser\x12\x17.user.DeleteUserRequest\x1a\x18.user.DeleteUserResponse\x12B\n" +
+	"\vRestoreUser\x12\x18.user.RestoreUserRequest\x1a\x19.user.RestoreUserResponse\x12J\n" +
+	"\x0eExportUserData\x12\x1b.user.ExportUserDataRequest\x1a\x19.user.ExportUserDataChunk0\x01\x12?\n" +
+	"\n" +
+	"GetProfile\x12\x17.user.GetProfileRequest\x1a\x18.user.GetProfileResponse\x12H\n" +
+	"\rUpdateProfile\x12\x1a.user.UpdateProfileRequest\x1a\x1b.user.UpdateProfileResponse\x12T\n" +
+	"\x11GetPublicProfiles\x12\x1e.user.GetPublicProfilesRequest\x1a\x1f.user.GetPublicProfilesResponse\x12?\n" +
+	"\n" +
+	"GetRatings\x12\x17.user.GetRatingsRequest\x1a\x18.user.GetRatingsResponse\x12K\n" +
+	"\x0eGetRatingChart\x12\x1b.user.GetRatingChartRequest\x1a\x1c.user.GetRatingChartResponse\x12Q\n" +
+	"\x10RecordGameResult\x12\x1d.user.RecordGameResultRequest\x1a\x1e.user.RecordGameResultResponse\x12K\n" +
+	"\x0eGetLeaderboard\x12\x1b.user.GetLeaderboardRequest\x1a\x1c.user.GetLeaderboardResponse\x12i\n" +
+	"\x18GetLeaderboardAroundUser\x12%.user.GetLeaderboardAroundUserRequest\x1a&.user.GetLeaderboardAroundUserResponse\x12B\n" +
+	"\vGetUserRank\x12\x18.user.GetUserRankRequest\x1a\x19.user.GetUserRankResponse\x12E\n" +
+	"\fAdjustRating\x12\x19.user.AdjustRatingRequest\x1a\x1a.user.AdjustRatingResponse\x12I\n" +
+	"\x0ePenalizeRating\x12\x1b.user.PenalizeRatingRequest\x1a\x1a.user.AdjustRatingResponse\x12N\n" +
+	"\x0fRefundOpponents\x12\x1c.user.RefundOpponentsRequest\x1a\x1d.user.RefundOpponentsResponse\x12B\n" +
+	"\vStartSeason\x12\x18.user.StartSeasonRequest\x1a\x19.user.StartSeasonResponse\x12W\n" +
+	"\x12GetSeasonStandings\x12\x1f.user.GetSeasonStandingsRequest\x1a .user.GetSeasonStandingsResponse\x12H\n" +
+	"\rGetHeadToHead\x12\x1a.user.GetHeadToHeadRequest\x1a\x1b.user.GetHeadToHeadResponse\x12N\n" +
+	"\x0fGetTopOpponents\x12\x1c.user.GetTopOpponentsRequest\x1a\x1d.user.GetTopOpponentsResponse\x12C\n" +
+	"\vSuspendUser\x12\x18.user.SuspendUserRequest\x1a\x1a.user.ModerateUserResponse\x12;\n" +
+	"\aBanUser\x12\x14.user.BanUserRequest\x1a\x1a.user.ModerateUserResponse\x12G\n" +
+	"\rReinstateUser\x12\x1a.user.ReinstateUserRequest\x1a\x1a.user.ModerateUserResponse\x12Q\n" +
+	"\x10GetModerationLog\x12\x1d.user.GetModerationLogRequest\x1a\x1e.user.GetModerationLogResponse\x12@\n" +
+	"\fGrantPremium\x12\x19.user.GrantPremiumRequest\x1a\x15.user.PremiumResponse\x12B\n" +
+	"\rRevokePremium\x12\x1a.user.RevokePremiumRequest\x1a\x15.user.PremiumResponse\x12Q\n" +
+	"\x10GetPremiumStatus\x12\x1d.user.GetPremiumStatusRequest\x1a\x1e.user.GetPremiumStatusResponseBiZggithub.com/EugeneTsydenov/chesshub-user-service/internal/controllers/grpccontrollers/genproto;userprotob\x06proto3"
+
+var (
+	file_user_proto_rawDescOnce sync.Once
+	file_user_proto_rawDescData []byte
+)
+
+func file_user_proto_rawDescGZIP() []byte {
+	file_user_proto_rawDescOnce.Do(func() {
+		file_user_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_user_proto_rawDesc), len(file_user_proto_rawDesc)))
+	})
+	return file_user_proto_rawDescData
+}
+
+var file_user_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 80)
+var file_user_proto_goTypes = []any{
+	(UserStatus)(0),                          // 0: user.UserStatus
+	(ModerationAction)(0),                    // 1: user.ModerationAction
+	(TimeControl)(0),                         // 2: user.TimeControl
+	(ChangeReason)(0),                        // 3: user.ChangeReason
+	(ChartInterval)(0),                       // 4: user.ChartInterval
+	(GameOutcome)(0),                         // 5: user.GameOutcome
+	(*User)(nil),                             // 6: user.User
+	(*Profile)(nil),                          // 7: user.Profile
+	(*Rating)(nil),                           // 8: user.Rating
+	(*RatingHistory)(nil),                    // 9: user.RatingHistory
+	(*RegisterUserRequest)(nil),              // 10: user.RegisterUserRequest
+	(*RegisterUserResponse)(nil),             // 11: user.RegisterUserResponse
+	(*VerifyCredentialsRequest)(nil),         // 12: user.VerifyCredentialsRequest
+	(*VerifyCredentialsResponse)(nil),        // 13: user.VerifyCredentialsResponse
+	(*SendEmailVerificationRequest)(nil),     // 14: user.SendEmailVerificationRequest
+	(*SendEmailVerificationResponse)(nil),    // 15: user.SendEmailVerificationResponse
+	(*ConfirmEmailRequest)(nil),              // 16: user.ConfirmEmailRequest
+	(*ConfirmEmailResponse)(nil),             // 17: user.ConfirmEmailResponse
+	(*RequestPasswordResetRequest)(nil),      // 18: user.RequestPasswordResetRequest
+	(*RequestPasswordResetResponse)(nil),     // 19: user.RequestPasswordResetResponse
+	(*ConfirmPasswordResetRequest)(nil),      // 20: user.ConfirmPasswordResetRequest
+	(*ConfirmPasswordResetResponse)(nil),     // 21: user.ConfirmPasswordResetResponse
+	(*ChangePasswordRequest)(nil),            // 22: user.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),           // 23: user.ChangePasswordResponse
+	(*GetUserRequest)(nil),                   // 24: user.GetUserRequest
+	(*GetUserByTagRequest)(nil),              // 25: user.GetUserByTagRequest
+	(*GetUserResponse)(nil),                  // 26: user.GetUserResponse
+	(*UpdateUserRequest)(nil),                // 27: user.UpdateUserRequest
+	(*UpdateUserResponse)(nil),               // 28: user.UpdateUserResponse
+	(*ChangeTagRequest)(nil),                 // 29: user.ChangeTagRequest
+	(*ChangeTagResponse)(nil),                // 30: user.ChangeTagResponse
+	(*DeleteUserRequest)(nil),                // 31: user.DeleteUserRequest
+	(*DeleteUserResponse)(nil),               // 32: user.DeleteUserResponse
+	(*RestoreUserRequest)(nil),               // 33: user.RestoreUserRequest
+	(*RestoreUserResponse)(nil),              // 34: user.RestoreUserResponse
+	(*ExportUserDataRequest)(nil),            // 35: user.ExportUserDataRequest
+	(*ExportUserDataChunk)(nil),              // 36: user.ExportUserDataChunk
+	(*GetProfileRequest)(nil),                // 37: user.GetProfileRequest
+	(*GetProfileResponse)(nil),               // 38: user.GetProfileResponse
+	(*UpdateProfileRequest)(nil),             // 39: user.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),            // 40: user.UpdateProfileResponse
+	(*GetPublicProfilesRequest)(nil),         // 41: user.GetPublicProfilesRequest
+	(*GetPublicProfilesResponse)(nil),        // 42: user.GetPublicProfilesResponse
+	(*GetRatingsRequest)(nil),                // 43: user.GetRatingsRequest
+	(*GetRatingsResponse)(nil),               // 44: user.GetRatingsResponse
+	(*GetRatingChartRequest)(nil),            // 45: user.GetRatingChartRequest
+	(*RatingBucket)(nil),                     // 46: user.RatingBucket
+	(*GetRatingChartResponse)(nil),           // 47: user.GetRatingChartResponse
+	(*AdjustRatingRequest)(nil),              // 48: user.AdjustRatingRequest
+	(*PenalizeRatingRequest)(nil),            // 49: user.PenalizeRatingRequest
+	(*AdjustRatingResponse)(nil),             // 50: user.AdjustRatingResponse
+	(*RefundOpponentsRequest)(nil),           // 51: user.RefundOpponentsRequest
+	(*RefundOpponentsResponse)(nil),          // 52: user.RefundOpponentsResponse
+	(*Season)(nil),                           // 53: user.Season
+	(*StartSeasonRequest)(nil),               // 54: user.StartSeasonRequest
+	(*StartSeasonResponse)(nil),              // 55: user.StartSeasonResponse
+	(*GetSeasonStandingsRequest)(nil),        // 56: user.GetSeasonStandingsRequest
+	(*SeasonStanding)(nil),                   // 57: user.SeasonStanding
+	(*GetSeasonStandingsResponse)(nil),       // 58: user.GetSeasonStandingsResponse
+	(*HeadToHeadRecord)(nil),                 // 59: user.HeadToHeadRecord
+	(*GetHeadToHeadRequest)(nil),             // 60: user.GetHeadToHeadRequest
+	(*GetHeadToHeadResponse)(nil),            // 61: user.GetHeadToHeadResponse
+	(*GetTopOpponentsRequest)(nil),           // 62: user.GetTopOpponentsRequest
+	(*GetTopOpponentsResponse)(nil),          // 63: user.GetTopOpponentsResponse
+	(*RecordGameResultRequest)(nil),          // 64: user.RecordGameResultRequest
+	(*RecordGameResultResponse)(nil),         // 65: user.RecordGameResultResponse
+	(*GetLeaderboardRequest)(nil),            // 66: user.GetLeaderboardRequest
+	(*LeaderboardCursor)(nil),                // 67: user.LeaderboardCursor
+	(*LeaderboardEntry)(nil),                 // 68: user.LeaderboardEntry
+	(*GetLeaderboardResponse)(nil),           // 69: user.GetLeaderboardResponse
+	(*GetLeaderboardAroundUserRequest)(nil),  // 70: user.GetLeaderboardAroundUserRequest
+	(*GetLeaderboardAroundUserResponse)(nil), // 71: user.GetLeaderboardAroundUserResponse
+	(*GetUserRankRequest)(nil),               // 72: user.GetUserRankRequest
+	(*GetUserRankResponse)(nil),              // 73: user.GetUserRankResponse
+	(*SuspendUserRequest)(nil),               // 74: user.SuspendUserRequest
+	(*BanUserRequest)(nil),                   // 75: user.BanUserRequest
+	(*ReinstateUserRequest)(nil),             // 76: user.ReinstateUserRequest
+	(*ModerateUserResponse)(nil),             // 77: user.ModerateUserResponse
+	(*ModerationRecord)(nil),                 // 78: user.ModerationRecord
+	(*GetModerationLogRequest)(nil),          // 79: user.GetModerationLogRequest
+	(*GetModerationLogResponse)(nil),         // 80: user.GetModerationLogResponse
+	(*GrantPremiumRequest)(nil),              // 81: user.GrantPremiumRequest
+	(*RevokePremiumRequest)(nil),             // 82: user.RevokePremiumRequest
+	(*PremiumResponse)(nil),                  // 83: user.PremiumResponse
+	(*GetPremiumStatusRequest)(nil),          // 84: user.GetPremiumStatusRequest
+	(*GetPremiumStatusResponse)(nil),         // 85: user.GetPremiumStatusResponse
+	(*timestamppb.Timestamp)(nil),            // 86: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),              // 87: google.protobuf.Duration
+}
+var file_user_proto_depIdxs = []int32{
+	0,   // 0: user.User.status:type_name -> user.UserStatus
+	86,  // 1: user.User.email_verified_at:type_name -> google.protobuf.Timestamp
+	86,  // 2: user.User.premium_until:type_name -> google.protobuf.Timestamp
+	86,  // 3: user.User.last_active_at:type_name -> google.protobuf.Timestamp
+	86,  // 4: user.User.last_login_at:type_name -> google.protobuf.Timestamp
+	86,  // 5: user.User.updated_at:type_name -> google.protobuf.Timestamp
+	86,  // 6: user.User.created_at:type_name -> google.protobuf.Timestamp
+	86,  // 7: user.User.suspended_until:type_name -> google.protobuf.Timestamp
+	86,  // 8: user.User.deleted_at:type_name -> google.protobuf.Timestamp
+	86,  // 9: user.Profile.birth_date:type_name -> google.protobuf.Timestamp
+	86,  // 10: user.Profile.created_at:type_name -> google.protobuf.Timestamp
+	86,  // 11: user.Profile.updated_at:type_name -> google.protobuf.Timestamp
+	2,   // 12: user.Rating.time_control:type_name -> user.TimeControl
+	86,  // 13: user.Rating.last_game_at:type_name -> google.protobuf.Timestamp
+	86,  // 14: user.Rating.created_at:type_name -> google.protobuf.Timestamp
+	86,  // 15: user.Rating.updated_at:type_name -> google.protobuf.Timestamp
+	2,   // 16: user.RatingHistory.time_control:type_name -> user.TimeControl
+	3,   // 17: user.RatingHistory.change_reason:type_name -> user.ChangeReason
+	86,  // 18: user.RatingHistory.created_at:type_name -> google.protobuf.Timestamp
+	6,   // 19: user.VerifyCredentialsResponse.user:type_name -> user.User
+	6,   // 20: user.ConfirmEmailResponse.user:type_name -> user.User
+	6,   // 21: user.GetUserResponse.user:type_name -> user.User
+	6,   // 22: user.UpdateUserResponse.user:type_name -> user.User
+	6,   // 23: user.ChangeTagResponse.user:type_name -> user.User
+	87,  // 24: user.DeleteUserResponse.grace_period:type_name -> google.protobuf.Duration
+	6,   // 25: user.RestoreUserResponse.user:type_name -> user.User
+	7,   // 26: user.GetProfileResponse.profile:type_name -> user.Profile
+	86,  // 27: user.UpdateProfileRequest.birth_date:type_name -> google.protobuf.Timestamp
+	7,   // 28: user.UpdateProfileResponse.profile:type_name -> user.Profile
+	7,   // 29: user.GetPublicProfilesResponse.profiles:type_name -> user.Profile
+	2,   // 30: user.GetRatingsRequest.time_control:type_name -> user.TimeControl
+	8,   // 31: user.GetRatingsResponse.ratings:type_name -> user.Rating
+	9,   // 32: user.GetRatingsResponse.history:type_name -> user.RatingHistory
+	2,   // 33: user.GetRatingChartRequest.time_control:type_name -> user.TimeControl
+	4,   // 34: user.GetRatingChartRequest.interval:type_name -> user.ChartInterval
+	86,  // 35: user.GetRatingChartRequest.from:type_name -> google.protobuf.Timestamp
+	86,  // 36: user.GetRatingChartRequest.to:type_name -> google.protobuf.Timestamp
+	86,  // 37: user.RatingBucket.start:type_name -> google.protobuf.Timestamp
+	4,   // 38: user.GetRatingChartResponse.interval:type_name -> user.ChartInterval
+	46,  // 39: user.GetRatingChartResponse.buckets:type_name -> user.RatingBucket
+	2,   // 40: user.AdjustRatingRequest.time_control:type_name -> user.TimeControl
+	2,   // 41: user.PenalizeRatingRequest.time_control:type_name -> user.TimeControl
+	8,   // 42: user.AdjustRatingResponse.rating:type_name -> user.Rating
+	9,   // 43: user.AdjustRatingResponse.history:type_name -> user.RatingHistory
+	9,   // 44: user.RefundOpponentsResponse.refunds:type_name -> user.RatingHistory
+	86,  // 45: user.Season.started_at:type_name -> google.protobuf.Timestamp
+	86,  // 46: user.Season.ended_at:type_name -> google.protobuf.Timestamp
+	53,  // 47: user.StartSeasonResponse.season:type_name -> user.Season
+	53,  // 48: user.StartSeasonResponse.ended:type_name -> user.Season
+	2,   // 49: user.GetSeasonStandingsRequest.time_control:type_name -> user.TimeControl
+	67,  // 50: user.GetSeasonStandingsRequest.after:type_name -> user.LeaderboardCursor
+	57,  // 51: user.GetSeasonStandingsResponse.standings:type_name -> user.SeasonStanding
+	67,  // 52: user.GetSeasonStandingsResponse.next:type_name -> user.LeaderboardCursor
+	2,   // 53: user.HeadToHeadRecord.time_control:type_name -> user.TimeControl
+	86,  // 54: user.HeadToHeadRecord.last_game_at:type_name -> google.protobuf.Timestamp
+	59,  // 55: user.GetHeadToHeadResponse.records:type_name -> user.HeadToHeadRecord
+	59,  // 56: user.GetHeadToHeadResponse.total:type_name -> user.HeadToHeadRecord
+	2,   // 57: user.GetTopOpponentsRequest.time_control:type_name -> user.TimeControl
+	59,  // 58: user.GetTopOpponentsResponse.opponents:type_name -> user.HeadToHeadRecord
+	2,   // 59: user.RecordGameResultRequest.time_control:type_name -> user.TimeControl
+	5,   // 60: user.RecordGameResultRequest.outcome:type_name -> user.GameOutcome
+	86,  // 61: user.RecordGameResultRequest.finished_at:type_name -> google.protobuf.Timestamp
+	8,   // 62: user.RecordGameResultResponse.white:type_name -> user.Rating
+	8,   // 63: user.RecordGameResultResponse.black:type_name -> user.Rating
+	2,   // 64: user.GetLeaderboardRequest.time_control:type_name -> user.TimeControl
+	67,  // 65: user.GetLeaderboardRequest.after:type_name -> user.LeaderboardCursor
+	8,   // 66: user.LeaderboardEntry.rating:type_name -> user.Rating
+	68,  // 67: user.GetLeaderboardResponse.entries:type_name -> user.LeaderboardEntry
+	67,  // 68: user.GetLeaderboardResponse.next:type_name -> user.LeaderboardCursor
+	2,   // 69: user.GetLeaderboardAroundUserRequest.time_control:type_name -> user.TimeControl
+	68,  // 70: user.GetLeaderboardAroundUserResponse.entries:type_name -> user.LeaderboardEntry
+	2,   // 71: user.GetUserRankRequest.time_control:type_name -> user.TimeControl
+	86,  // 72: user.SuspendUserRequest.until:type_name -> google.protobuf.Timestamp
+	87,  // 73: user.SuspendUserRequest.duration:type_name -> google.protobuf.Duration
+	6,   // 74: user.ModerateUserResponse.user:type_name -> user.User
+	1,   // 75: user.ModerationRecord.action:type_name -> user.ModerationAction
+	86,  // 76: user.ModerationRecord.suspended_until:type_name -> google.protobuf.Timestamp
+	86,  // 77: user.ModerationRecord.created_at:type_name -> google.protobuf.Timestamp
+	78,  // 78: user.GetModerationLogResponse.records:type_name -> user.ModerationRecord
+	87,  // 79: user.GrantPremiumRequest.duration:type_name -> google.protobuf.Duration
+	6,   // 80: user.PremiumResponse.user:type_name -> user.User
+	86,  // 81: user.GetPremiumStatusRequest.at:type_name -> google.protobuf.Timestamp
+	86,  // 82: user.GetPremiumStatusResponse.premium_until:type_name -> google.protobuf.Timestamp
+	10,  // 83: user.UserService.RegisterUser:input_type -> user.RegisterUserRequest
+	12,  // 84: user.UserService.VerifyCredentials:input_type -> user.VerifyCredentialsRequest
+	14,  // 85: user.UserService.SendEmailVerification:input_type -> user.SendEmailVerificationRequest
+	16,  // 86: user.UserService.ConfirmEmail:input_type -> user.ConfirmEmailRequest
+	18,  // 87: user.UserService.RequestPasswordReset:input_type -> user.RequestPasswordResetRequest
+	20,  // 88: user.UserService.ConfirmPasswordReset:input_type -> user.ConfirmPasswordResetRequest
+	22,  // 89: user.UserService.ChangePassword:input_type -> user.ChangePasswordRequest
+	24,  // 90: user.UserService.GetUser:input_type -> user.GetUserRequest
+	25,  // 91: user.UserService.GetUserByTag:input_type -> user.GetUserByTagRequest
+	27,  // 92: user.UserService.UpdateUser:input_type -> user.UpdateUserRequest
+	29,  // 93: user.UserService.ChangeTag:input_type -> user.ChangeTagRequest
+	31,  // 94: user.UserService.DeleteUser:input_type -> user.DeleteUserRequest
+	33,  // 95: user.UserService.RestoreUser:input_type -> user.RestoreUserRequest
+	35,  // 96: user.UserService.ExportUserData:input_type -> user.ExportUserDataRequest
+	37,  // 97: user.UserService.GetProfile:input_type -> user.GetProfileRequest
+	39,  // 98: user.UserService.UpdateProfile:input_type -> user.UpdateProfileRequest
+	41,  // 99: user.UserService.GetPublicProfiles:input_type -> user.GetPublicProfilesRequest
+	43,  // 100: user.UserService.GetRatings:input_type -> user.GetRatingsRequest
+	45,  // 101: user.UserService.GetRatingChart:input_type -> user.GetRatingChartRequest
+	64,  // 102: user.UserService.RecordGameResult:input_type -> user.RecordGameResultRequest
+	66,  // 103: user.UserService.GetLeaderboard:input_type -> user.GetLeaderboardRequest
+	70,  // 104: user.UserService.GetLeaderboardAroundUser:input_type -> user.GetLeaderboardAroundUserRequest
+	72,  // 105: user.UserService.GetUserRank:input_type -> user.GetUserRankRequest
+	48,  // 106: user.UserService.AdjustRating:input_type -> user.AdjustRatingRequest
+	49,  // 107: user.UserService.PenalizeRating:input_type -> user.PenalizeRatingRequest
+	51,  // 108: user.UserService.RefundOpponents:input_type -> user.RefundOpponentsRequest
+	54,  // 109: user.UserService.StartSeason:input_type -> user.StartSeasonRequest
+	56,  // 110: user.UserService.GetSeasonStandings:input_type -> user.GetSeasonStandingsRequest
+	60,  // 111: user.UserService.GetHeadToHead:input_type -> user.GetHeadToHeadRequest
+	62,  // 112: user.UserService.GetTopOpponents:input_type -> user.GetTopOpponentsRequest
+	74,  // 113: user.UserService.SuspendUser:input_type -> user.SuspendUserRequest
+	75,  // 114: user.UserService.BanUser:input_type -> user.BanUserRequest
+	76,  // 115: user.UserService.ReinstateUser:input_type -> user.ReinstateUserRequest
+	79,  // 116: user.UserService.GetModerationLog:input_type -> user.GetModerationLogRequest
+	81,  // 117: user.UserService.GrantPremium:input_type -> user.GrantPremiumRequest
+	82,  // 118: user.UserService.RevokePremium:input_type -> user.RevokePremiumRequest
+	84,  // 119: user.UserService.GetPremiumStatus:input_type -> user.GetPremiumStatusRequest
+	11,  // 120: user.UserService.RegisterUser:output_type -> user.RegisterUserResponse
+	13,  // 121: user.UserService.VerifyCredentials:output_type -> user.VerifyCredentialsResponse
+	15,  // 122: user.UserService.SendEmailVerification:output_type -> user.SendEmailVerificationResponse
+	17,  // 123: user.UserService.ConfirmEmail:output_type -> user.ConfirmEmailResponse
+	19,  // 124: user.UserService.RequestPasswordReset:output_type -> user.RequestPasswordResetResponse
+	21,  // 125: user.UserService.ConfirmPasswordReset:output_type -> user.ConfirmPasswordResetResponse
+	23,  // 126: user.UserService.ChangePassword:output_type -> user.ChangePasswordResponse
+	26,  // 127: user.UserService.GetUser:output_type -> user.GetUserResponse
+	26,  // 128: user.UserService.GetUserByTag:output_type -> user.GetUserResponse
+	28,  // 129: user.UserService.UpdateUser:output_type -> user.UpdateUserResponse
+	30,  // 130: user.UserService.ChangeTag:output_type -> user.ChangeTagResponse
+	32,  // 131: user.UserService.DeleteUser:output_type -> user.DeleteUserResponse
+	34,  // 132: user.UserService.RestoreUser:output_type -> user.RestoreUserResponse
+	36,  // 133: user.UserService.ExportUserData:output_type -> user.ExportUserDataChunk
+	38,  // 134: user.UserService.GetProfile:output_type -> user.GetProfileResponse
+	40,  // 135: user.UserService.UpdateProfile:output_type -> user.UpdateProfileResponse
+	42,  // 136: user.UserService.GetPublicProfiles:output_type -> user.GetPublicProfilesResponse
+	44,  // 137: user.UserService.GetRatings:output_type -> user.GetRatingsResponse
+	47,  // 138: user.UserService.GetRatingChart:output_type -> user.GetRatingChartResponse
+	65,  // 139: user.UserService.RecordGameResult:output_type -> user.RecordGameResultResponse
+	69,  // 140: user.UserService.GetLeaderboard:output_type -> user.GetLeaderboardResponse
+	71,  // 141: user.UserService.GetLeaderboardAroundUser:output_type -> user.GetLeaderboardAroundUserResponse
+	73,  // 142: user.UserService.GetUserRank:output_type -> user.GetUserRankResponse
+	50,  // 143: user.UserService.AdjustRating:output_type -> user.AdjustRatingResponse
+	50,  // 144: user.UserService.PenalizeRating:output_type -> user.AdjustRatingResponse
+	52,  // 145: user.UserService.RefundOpponents:output_type -> user.RefundOpponentsResponse
+	55,  // 146: user.UserService.StartSeason:output_type -> user.StartSeasonResponse
+	58,  // 147: user.UserService.GetSeasonStandings:output_type -> user.GetSeasonStandingsResponse
+	61,  // 148: user.UserService.GetHeadToHead:output_type -> user.GetHeadToHeadResponse
+	63,  // 149: user.UserService.GetTopOpponents:output_type -> user.GetTopOpponentsResponse
+	77,  // 150: user.UserService.SuspendUser:output_type -> user.ModerateUserResponse
+	77,  // 151: user.UserService.BanUser:output_type -> user.ModerateUserResponse
+	77,  // 152: user.UserService.ReinstateUser:output_type -> user.ModerateUserResponse
+	80,  // 153: user.UserService.GetModerationLog:output_type -> user.GetModerationLogResponse
+	83,  // 154: user.UserService.GrantPremium:output_type -> user.PremiumResponse
+	83,  // 155: user.UserService.RevokePremium:output_type -> user.PremiumResponse
+	85,  // 156: user.UserService.GetPremiumStatus:output_type -> user.GetPremiumStatusResponse
+	120, // [120:157] is the sub-list for method output_type
+	83,  // [83:120] is the sub-list for method input_type
+	83,  // [83:83] is the sub-list for extension type_name
+	83,  // [83:83] is the sub-list for extension extendee
+	0,   // [0:83] is the sub-list for field type_name
+}
+
+func init() { file_user_proto_init() }
+func file_user_proto_init() {
+	if File_user_proto != nil {
+		return
+	}
+	file_user_proto_msgTypes[0].OneofWrappers = []any{}
+	file_user_proto_msgTypes[1].OneofWrappers = []any{}
+	file_user_proto_msgTypes[2].OneofWrappers = []any{}
+	file_user_proto_msgTypes[3].OneofWrappers = []any{}
+	file_user_proto_msgTypes[6].OneofWrappers = []any{
+		(*VerifyCredentialsRequest_Email)(nil),
+		(*VerifyCredentialsRequest_Tag)(nil),
+	}
+	file_user_proto_msgTypes[20].OneofWrappers = []any{}
+	file_user_proto_msgTypes[21].OneofWrappers = []any{}
+	file_user_proto_msgTypes[26].OneofWrappers = []any{}
+	file_user_proto_msgTypes[33].OneofWrappers = []any{}
+	file_user_proto_msgTypes[37].OneofWrappers = []any{}
+	file_user_proto_msgTypes[39].OneofWrappers = []any{}
+	file_user_proto_msgTypes[42].OneofWrappers = []any{
+		(*AdjustRatingRequest_Delta)(nil),
+		(*AdjustRatingRequest_Value)(nil),
+	}
+	file_user_proto_msgTypes[47].OneofWrappers = []any{}
+	file_user_proto_msgTypes[49].OneofWrappers = []any{}
+	file_user_proto_msgTypes[50].OneofWrappers = []any{}
+	file_user_proto_msgTypes[52].OneofWrappers = []any{}
+	file_user_proto_msgTypes[53].OneofWrappers = []any{}
+	file_user_proto_msgTypes[56].OneofWrappers = []any{}
+	file_user_proto_msgTypes[58].OneofWrappers = []any{}
+	file_user_proto_msgTypes[60].OneofWrappers = []any{}
+	file_user_proto_msgTypes[63].OneofWrappers = []any{}
+	file_user_proto_msgTypes[68].OneofWrappers = []any{
+		(*SuspendUserRequest_Until)(nil),
+		(*SuspendUserRequest_Duration)(nil),
+	}
+	file_user_proto_msgTypes[72].OneofWrappers = []any{}
+	file_user_proto_msgTypes[78].OneofWrappers = []any{}
+	file_user_proto_msgTypes[79].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_proto_rawDesc), len(file_user_proto_rawDesc)),
+			NumEnums:      6,
+			NumMessages:   80,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_user_proto_goTypes,
+		DependencyIndexes: file_user_proto_depIdxs,
+		EnumInfos:         file_user_proto_enumTypes,
+		MessageInfos:      file_user_proto_msgTypes,
+	}.Build()
+	File_user_proto = out.File
+	file_user_proto_goTypes = nil
+	file_user_proto_depIdxs = nil
+}
@@ -38,6 +38,8 @@ func appErrorToGRPCError(err *apperrors.AppError) error {
 		return status.Error(codes.Canceled, err.Message)
 	case apperrors.DeadlineExceeded:
 		return status.Error(codes.DeadlineExceeded, err.Message)
+	case apperrors.ResourceExhausted:
+		return status.Error(codes.ResourceExhausted, err.Message)
 	default:
 		return status.Error(codes.Unknown, err.Message)
 	}
@@ -0,0 +1,56 @@
+package grpccontrollers
+
+import (
+	"context"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	userproto "github.com/EugeneTsydenov/chesshub-user-service/internal/controllers/grpccontrollers/genproto"
+)
+
+func (c *UserController) GetHeadToHead(
+	ctx context.Context,
+	req *userproto.GetHeadToHeadRequest,
+) (*userproto.GetHeadToHeadResponse, error) {
+	output, err := c.useCases.GetHeadToHead.Execute(ctx, &dto.GetHeadToHeadInputDTO{
+		UserID:     req.GetUserId(),
+		OpponentID: req.GetOpponentId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &userproto.GetHeadToHeadResponse{
+		Records: make([]*userproto.HeadToHeadRecord, 0, len(output.Records)),
+		Total:   toProtoHeadToHead(output.Total),
+	}
+
+	for _, h := range output.Records {
+		resp.Records = append(resp.Records, toProtoHeadToHead(h))
+	}
+
+	return resp, nil
+}
+
+func (c *UserController) GetTopOpponents(
+	ctx context.Context,
+	req *userproto.GetTopOpponentsRequest,
+) (*userproto.GetTopOpponentsResponse, error) {
+	output, err := c.useCases.GetTopOpponents.Execute(ctx, &dto.GetTopOpponentsInputDTO{
+		UserID:      req.GetUserId(),
+		TimeControl: string(fromProtoTimeControl(req.GetTimeControl())),
+		Limit:       int(req.GetLimit()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &userproto.GetTopOpponentsResponse{
+		Opponents: make([]*userproto.HeadToHeadRecord, 0, len(output.Opponents)),
+	}
+
+	for _, h := range output.Opponents {
+		resp.Opponents = append(resp.Opponents, toProtoHeadToHead(h))
+	}
+
+	return resp, nil
+}
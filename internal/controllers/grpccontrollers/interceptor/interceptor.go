@@ -32,3 +32,22 @@ func ErrorHandlingInterceptor(logger *logrus.Logger) grpc.UnaryServerInterceptor
 		return resp, nil
 	}
 }
+
+func ErrorHandlingStreamInterceptor(logger *logrus.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := handler(srv, ss)
+		if err != nil {
+			requestID, _ := ss.Context().Value("request-id").(string)
+			logger.
+				WithField("server", srv).
+				WithField("method", info.FullMethod).
+				WithField("request-id", requestID).
+				WithField("cause", errors.Unwrap(err)).
+				WithField("error", err).
+				Error("[Error handling interceptor]: gRPC stream failed")
+			return grpcerrors.ToGRPCError(err)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,260 @@
+package grpccontrollers
+
+import (
+	"time"
+
+	userproto "github.com/EugeneTsydenov/chesshub-user-service/internal/controllers/grpccontrollers/genproto"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var userStatuses = map[enums.UserStatus]userproto.UserStatus{
+	enums.UserStatusActive:    userproto.UserStatus_USER_STATUS_ACTIVE,
+	enums.UserStatusSuspended: userproto.UserStatus_USER_STATUS_SUSPENDED,
+	enums.UserStatusBanned:    userproto.UserStatus_USER_STATUS_BANNED,
+	enums.UserStatusDeleted:   userproto.UserStatus_USER_STATUS_DELETED,
+}
+
+var moderationActions = map[enums.ModerationAction]userproto.ModerationAction{
+	enums.ModerationActionSuspend:   userproto.ModerationAction_MODERATION_ACTION_SUSPEND,
+	enums.ModerationActionBan:       userproto.ModerationAction_MODERATION_ACTION_BAN,
+	enums.ModerationActionReinstate: userproto.ModerationAction_MODERATION_ACTION_REINSTATE,
+}
+
+var timeControls = map[enums.TimeControl]userproto.TimeControl{
+	enums.TimeControlBullet:         userproto.TimeControl_TIME_CONTROL_BULLET,
+	enums.TimeControlBlitz:          userproto.TimeControl_TIME_CONTROL_BLITZ,
+	enums.TimeControlRapid:          userproto.TimeControl_TIME_CONTROL_RAPID,
+	enums.TimeControlClassical:      userproto.TimeControl_TIME_CONTROL_CLASSICAL,
+	enums.TimeControlCorrespondence: userproto.TimeControl_TIME_CONTROL_CORRESPONDENCE,
+}
+
+var changeReasons = map[enums.ChangeReason]userproto.ChangeReason{
+	enums.ChangeReasonGame:        userproto.ChangeReason_CHANGE_REASON_GAME,
+	enums.ChangeReasonAdjustment:  userproto.ChangeReason_CHANGE_REASON_ADJUSTMENT,
+	enums.ChangeReasonSeasonReset: userproto.ChangeReason_CHANGE_REASON_SEASON_RESET,
+	enums.ChangeReasonPenalty:     userproto.ChangeReason_CHANGE_REASON_PENALTY,
+	enums.ChangeReasonRefund:      userproto.ChangeReason_CHANGE_REASON_REFUND,
+}
+
+var chartIntervals = map[enums.ChartInterval]userproto.ChartInterval{
+	enums.ChartIntervalDay:   userproto.ChartInterval_CHART_INTERVAL_DAY,
+	enums.ChartIntervalWeek:  userproto.ChartInterval_CHART_INTERVAL_WEEK,
+	enums.ChartIntervalMonth: userproto.ChartInterval_CHART_INTERVAL_MONTH,
+}
+
+var gameOutcomes = map[userproto.GameOutcome]enums.GameOutcome{
+	userproto.GameOutcome_GAME_OUTCOME_WHITE_WINS: enums.GameOutcomeWhiteWins,
+	userproto.GameOutcome_GAME_OUTCOME_BLACK_WINS: enums.GameOutcomeBlackWins,
+	userproto.GameOutcome_GAME_OUTCOME_DRAW:       enums.GameOutcomeDraw,
+}
+
+// fromProtoTimeControl returns an empty time control for unspecified values so use cases report them as invalid.
+func fromProtoTimeControl(tc userproto.TimeControl) enums.TimeControl {
+	for domainTC, protoTC := range timeControls {
+		if protoTC == tc {
+			return domainTC
+		}
+	}
+
+	return ""
+}
+
+// fromProtoChartInterval returns an empty interval for unspecified values so use cases apply their default, unknown
+// values stay invalid.
+func fromProtoChartInterval(ci userproto.ChartInterval) enums.ChartInterval {
+	if ci == userproto.ChartInterval_CHART_INTERVAL_UNSPECIFIED {
+		return ""
+	}
+
+	for domainCI, protoCI := range chartIntervals {
+		if protoCI == ci {
+			return domainCI
+		}
+	}
+
+	return enums.ChartInterval(ci.String())
+}
+
+func toProtoUser(u *user.User) *userproto.User {
+	return &userproto.User{
+		Id:              u.ID(),
+		Tag:             u.Tag().Value(),
+		Email:           u.Email().Value(),
+		Status:          userStatuses[u.Status()],
+		IsVerified:      u.IsVerified(),
+		IsPremium:       u.IsPremium(),
+		EmailVerifiedAt: toProtoTimestamp(u.EmailVerifiedAt()),
+		PremiumUntil:    toProtoTimestamp(u.PremiumUntil()),
+		SuspendedUntil:  toProtoTimestamp(u.SuspendedUntil()),
+		DeletedAt:       toProtoTimestamp(u.DeletedAt()),
+		Language:        u.Language(),
+		LastActiveAt:    timestamppb.New(u.LastActiveAt()),
+		LastLoginAt:     toProtoTimestamp(u.LastLoginAt()),
+		UpdatedAt:       timestamppb.New(u.UpdatedAt()),
+		CreatedAt:       timestamppb.New(u.CreatedAt()),
+	}
+}
+
+func toProtoProfile(p *user.Profile) *userproto.Profile {
+	profile := &userproto.Profile{
+		UserId:            p.UserID,
+		Bio:               p.Bio,
+		CountryCode:       p.CountryCode,
+		City:              p.City,
+		BirthDate:         toProtoTimestamp(p.BirthDate),
+		AvatarUrl:         p.AvatarURL,
+		CoverImageUrl:     p.CoverImageURL,
+		IsPublic:          p.IsPublic,
+		ShowCountry:       p.ShowCountry,
+		WebsiteUrl:        p.WebsiteURL,
+		TwitchUsername:    p.TwitchUsername,
+		YoutubeChannelUrl: p.YoutubeChannelURL,
+		CreatedAt:         timestamppb.New(p.CreatedAt),
+		UpdatedAt:         timestamppb.New(p.UpdatedAt),
+	}
+
+	if p.PublicName != nil {
+		profile.PublicName = p.PublicName.Value()
+	}
+
+	return profile
+}
+
+func toProtoModerationRecord(r *user.ModerationRecord) *userproto.ModerationRecord {
+	return &userproto.ModerationRecord{
+		Id:             r.ID,
+		UserId:         r.UserID,
+		Action:         moderationActions[r.Action],
+		ActorId:        r.ActorID,
+		Reason:         r.Reason,
+		SuspendedUntil: toProtoTimestamp(r.SuspendedUntil),
+		CreatedAt:      timestamppb.New(r.CreatedAt),
+	}
+}
+
+func toProtoTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
+func toProtoRating(r *user.Rating) *userproto.Rating {
+	return &userproto.Rating{
+		Id:           r.Id.String(),
+		UserId:       r.UserID,
+		TimeControl:  timeControls[r.TimeControl],
+		Rating:       int32(r.Rating),
+		Deviation:    r.Deviation,
+		Volatility:   r.Volatility,
+		PeakRating:   int32(r.PeakRating),
+		LowestRating: int32(r.LowestRating),
+		GamesPlayed:  int32(r.GamesPlayed),
+		Wins:         int32(r.Wins),
+		Losses:       int32(r.Losses),
+		Draws:        int32(r.Draws),
+		LastGameAt:   toProtoTimestamp(r.LastGameAt),
+		CreatedAt:    timestamppb.New(r.CreatedAt),
+		UpdatedAt:    timestamppb.New(r.UpdatedAt),
+		Provisional:  r.Provisional,
+	}
+}
+
+func toProtoRatingHistory(h *user.RatingHistory) *userproto.RatingHistory {
+	history := &userproto.RatingHistory{
+		Id:           h.Id.String(),
+		UserId:       h.UserID,
+		TimeControl:  timeControls[h.TimeControl],
+		OldRating:    int32(h.OldRating),
+		NewRating:    int32(h.NewRating),
+		RatingRange:  int32(h.RatingRange),
+		ChangeReason: changeReasons[h.ChangeReason],
+		CreatedAt:    timestamppb.New(h.CreatedAt),
+		ActorId:      h.ActorID,
+		Note:         h.Note,
+	}
+
+	if h.GameID != nil {
+		gameID := h.GameID.String()
+		history.GameId = &gameID
+	}
+
+	return history
+}
+
+func toProtoLeaderboardEntries(entries []*user.LeaderboardEntry) []*userproto.LeaderboardEntry {
+	result := make([]*userproto.LeaderboardEntry, 0, len(entries))
+
+	for _, e := range entries {
+		result = append(result, &userproto.LeaderboardEntry{
+			Rank:   int32(e.Rank),
+			Rating: toProtoRating(e.Rating),
+		})
+	}
+
+	return result
+}
+
+func toOptionalTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+
+	t := ts.AsTime()
+
+	return &t
+}
+
+func toProtoRatingBucket(b *user.RatingBucket) *userproto.RatingBucket {
+	return &userproto.RatingBucket{
+		Start: timestamppb.New(b.Start),
+		Open:  int32(b.Open),
+		Close: int32(b.Close),
+		Min:   int32(b.Min),
+		Max:   int32(b.Max),
+		Games: int32(b.Games),
+	}
+}
+
+func toProtoSeason(s *user.Season) *userproto.Season {
+	return &userproto.Season{
+		Id:        s.ID,
+		Name:      s.Name,
+		StartedAt: timestamppb.New(s.StartedAt),
+		EndedAt:   toProtoTimestamp(s.EndedAt),
+	}
+}
+
+func toProtoSeasonStanding(s *user.SeasonStanding) *userproto.SeasonStanding {
+	return &userproto.SeasonStanding{
+		Rank:        int32(s.Rank),
+		UserId:      s.UserID,
+		Rating:      int32(s.Rating),
+		Deviation:   s.Deviation,
+		GamesPlayed: int32(s.GamesPlayed),
+		Wins:        int32(s.Wins),
+		Losses:      int32(s.Losses),
+		Draws:       int32(s.Draws),
+	}
+}
+
+// toProtoHeadToHead leaves the last game time unset for records without games.
+func toProtoHeadToHead(h *user.HeadToHead) *userproto.HeadToHeadRecord {
+	record := &userproto.HeadToHeadRecord{
+		OpponentId:  h.OpponentID,
+		TimeControl: timeControls[h.TimeControl],
+		Wins:        int32(h.Wins),
+		Losses:      int32(h.Losses),
+		Draws:       int32(h.Draws),
+		Games:       int32(h.Games()),
+	}
+
+	if h.Games() > 0 {
+		record.LastGameAt = timestamppb.New(h.LastGameAt)
+	}
+
+	return record
+}
@@ -0,0 +1,84 @@
+package grpccontrollers
+
+import (
+	"context"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	userproto "github.com/EugeneTsydenov/chesshub-user-service/internal/controllers/grpccontrollers/genproto"
+)
+
+func (c *UserController) SuspendUser(
+	ctx context.Context,
+	req *userproto.SuspendUserRequest,
+) (*userproto.ModerateUserResponse, error) {
+	output, err := c.useCases.SuspendUser.Execute(ctx, &dto.SuspendUserInputDTO{
+		UserID:   req.GetUserId(),
+		ActorID:  req.GetActorId(),
+		Reason:   req.GetReason(),
+		Until:    toOptionalTime(req.GetUntil()),
+		Duration: req.GetDuration().AsDuration(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &userproto.ModerateUserResponse{
+		User: toProtoUser(output.User),
+	}, nil
+}
+
+func (c *UserController) BanUser(ctx context.Context, req *userproto.BanUserRequest) (*userproto.ModerateUserResponse, error) {
+	output, err := c.useCases.BanUser.Execute(ctx, &dto.BanUserInputDTO{
+		UserID:  req.GetUserId(),
+		ActorID: req.GetActorId(),
+		Reason:  req.GetReason(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &userproto.ModerateUserResponse{
+		User: toProtoUser(output.User),
+	}, nil
+}
+
+func (c *UserController) ReinstateUser(
+	ctx context.Context,
+	req *userproto.ReinstateUserRequest,
+) (*userproto.ModerateUserResponse, error) {
+	output, err := c.useCases.ReinstateUser.Execute(ctx, &dto.ReinstateUserInputDTO{
+		UserID:  req.GetUserId(),
+		ActorID: req.GetActorId(),
+		Reason:  req.GetReason(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &userproto.ModerateUserResponse{
+		User: toProtoUser(output.User),
+	}, nil
+}
+
+func (c *UserController) GetModerationLog(
+	ctx context.Context,
+	req *userproto.GetModerationLogRequest,
+) (*userproto.GetModerationLogResponse, error) {
+	output, err := c.useCases.GetModerationLog.Execute(ctx, &dto.GetModerationLogInputDTO{
+		UserID: req.GetUserId(),
+		Limit:  int(req.GetLimit()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &userproto.GetModerationLogResponse{
+		Records: make([]*userproto.ModerationRecord, 0, len(output.Records)),
+	}
+
+	for _, r := range output.Records {
+		resp.Records = append(resp.Records, toProtoModerationRecord(r))
+	}
+
+	return resp, nil
+}
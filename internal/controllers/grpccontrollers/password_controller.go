@@ -0,0 +1,59 @@
+package grpccontrollers
+
+import (
+	"context"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	userproto "github.com/EugeneTsydenov/chesshub-user-service/internal/controllers/grpccontrollers/genproto"
+)
+
+func (c *UserController) RequestPasswordReset(
+	ctx context.Context,
+	req *userproto.RequestPasswordResetRequest,
+) (*userproto.RequestPasswordResetResponse, error) {
+	output, err := c.useCases.RequestPasswordReset.Execute(ctx, &dto.RequestPasswordResetInputDTO{
+		Email: req.GetEmail(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &userproto.RequestPasswordResetResponse{
+		Message: output.Message,
+	}, nil
+}
+
+func (c *UserController) ConfirmPasswordReset(
+	ctx context.Context,
+	req *userproto.ConfirmPasswordResetRequest,
+) (*userproto.ConfirmPasswordResetResponse, error) {
+	output, err := c.useCases.ConfirmPasswordReset.Execute(ctx, &dto.ConfirmPasswordResetInputDTO{
+		Token:       req.GetToken(),
+		NewPassword: req.GetNewPassword(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &userproto.ConfirmPasswordResetResponse{
+		Message: output.Message,
+	}, nil
+}
+
+func (c *UserController) ChangePassword(
+	ctx context.Context,
+	req *userproto.ChangePasswordRequest,
+) (*userproto.ChangePasswordResponse, error) {
+	output, err := c.useCases.ChangePassword.Execute(ctx, &dto.ChangePasswordInputDTO{
+		UserID:      req.GetUserId(),
+		OldPassword: req.GetOldPassword(),
+		NewPassword: req.GetNewPassword(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &userproto.ChangePasswordResponse{
+		Message: output.Message,
+	}, nil
+}
@@ -0,0 +1,59 @@
+package grpccontrollers
+
+import (
+	"context"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	userproto "github.com/EugeneTsydenov/chesshub-user-service/internal/controllers/grpccontrollers/genproto"
+)
+
+func (c *UserController) GrantPremium(
+	ctx context.Context,
+	req *userproto.GrantPremiumRequest,
+) (*userproto.PremiumResponse, error) {
+	output, err := c.useCases.GrantPremium.Execute(ctx, &dto.GrantPremiumInputDTO{
+		UserID:   req.GetUserId(),
+		Duration: req.GetDuration().AsDuration(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &userproto.PremiumResponse{
+		User: toProtoUser(output.User),
+	}, nil
+}
+
+func (c *UserController) RevokePremium(
+	ctx context.Context,
+	req *userproto.RevokePremiumRequest,
+) (*userproto.PremiumResponse, error) {
+	output, err := c.useCases.RevokePremium.Execute(ctx, &dto.RevokePremiumInputDTO{
+		UserID: req.GetUserId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &userproto.PremiumResponse{
+		User: toProtoUser(output.User),
+	}, nil
+}
+
+func (c *UserController) GetPremiumStatus(
+	ctx context.Context,
+	req *userproto.GetPremiumStatusRequest,
+) (*userproto.GetPremiumStatusResponse, error) {
+	output, err := c.useCases.GetPremiumStatus.Execute(ctx, &dto.GetPremiumStatusInputDTO{
+		UserID: req.GetUserId(),
+		At:     toOptionalTime(req.GetAt()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &userproto.GetPremiumStatusResponse{
+		IsPremium:    output.IsPremium,
+		PremiumUntil: toProtoTimestamp(output.PremiumUntil),
+	}, nil
+}
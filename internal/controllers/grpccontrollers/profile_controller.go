@@ -0,0 +1,74 @@
+package grpccontrollers
+
+import (
+	"context"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	userproto "github.com/EugeneTsydenov/chesshub-user-service/internal/controllers/grpccontrollers/genproto"
+)
+
+func (c *UserController) GetProfile(
+	ctx context.Context,
+	req *userproto.GetProfileRequest,
+) (*userproto.GetProfileResponse, error) {
+	output, err := c.useCases.GetProfile.Execute(ctx, &dto.GetProfileInputDTO{
+		UserID: req.GetUserId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &userproto.GetProfileResponse{
+		Profile: toProtoProfile(output.Profile),
+	}, nil
+}
+
+func (c *UserController) GetPublicProfiles(
+	ctx context.Context,
+	req *userproto.GetPublicProfilesRequest,
+) (*userproto.GetPublicProfilesResponse, error) {
+	output, err := c.useCases.GetPublicProfiles.Execute(ctx, &dto.GetPublicProfilesInputDTO{
+		UserIDs: req.GetUserIds(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &userproto.GetPublicProfilesResponse{
+		Profiles: make([]*userproto.Profile, 0, len(output.Profiles)),
+	}
+
+	for _, p := range output.Profiles {
+		resp.Profiles = append(resp.Profiles, toProtoProfile(p))
+	}
+
+	return resp, nil
+}
+
+func (c *UserController) UpdateProfile(
+	ctx context.Context,
+	req *userproto.UpdateProfileRequest,
+) (*userproto.UpdateProfileResponse, error) {
+	output, err := c.useCases.UpdateProfile.Execute(ctx, &dto.UpdateProfileInputDTO{
+		UserID:            req.GetUserId(),
+		PublicName:        req.PublicName,
+		Bio:               req.Bio,
+		CountryCode:       req.CountryCode,
+		City:              req.City,
+		BirthDate:         toOptionalTime(req.GetBirthDate()),
+		AvatarURL:         req.AvatarUrl,
+		CoverImageURL:     req.CoverImageUrl,
+		IsPublic:          req.IsPublic,
+		ShowCountry:       req.ShowCountry,
+		WebsiteURL:        req.WebsiteUrl,
+		TwitchUsername:    req.TwitchUsername,
+		YoutubeChannelURL: req.YoutubeChannelUrl,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &userproto.UpdateProfileResponse{
+		Profile: toProtoProfile(output.Profile),
+	}, nil
+}
@@ -0,0 +1,86 @@
+package grpccontrollers
+
+import (
+	"context"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	userproto "github.com/EugeneTsydenov/chesshub-user-service/internal/controllers/grpccontrollers/genproto"
+)
+
+func (c *UserController) AdjustRating(
+	ctx context.Context,
+	req *userproto.AdjustRatingRequest,
+) (*userproto.AdjustRatingResponse, error) {
+	input := &dto.AdjustRatingInputDTO{
+		UserID:      req.GetUserId(),
+		TimeControl: string(fromProtoTimeControl(req.GetTimeControl())),
+		ActorID:     req.GetActorId(),
+		Note:        req.GetNote(),
+	}
+
+	switch change := req.GetChange().(type) {
+	case *userproto.AdjustRatingRequest_Delta:
+		delta := int(change.Delta)
+		input.Delta = &delta
+	case *userproto.AdjustRatingRequest_Value:
+		value := int(change.Value)
+		input.Value = &value
+	}
+
+	output, err := c.useCases.AdjustRating.Execute(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userproto.AdjustRatingResponse{
+		Rating:  toProtoRating(output.Rating),
+		History: toProtoRatingHistory(output.History),
+	}, nil
+}
+
+func (c *UserController) PenalizeRating(
+	ctx context.Context,
+	req *userproto.PenalizeRatingRequest,
+) (*userproto.AdjustRatingResponse, error) {
+	output, err := c.useCases.PenalizeRating.Execute(ctx, &dto.PenalizeRatingInputDTO{
+		UserID:      req.GetUserId(),
+		TimeControl: string(fromProtoTimeControl(req.GetTimeControl())),
+		Points:      int(req.GetPoints()),
+		ActorID:     req.GetActorId(),
+		Note:        req.GetNote(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &userproto.AdjustRatingResponse{
+		Rating:  toProtoRating(output.Rating),
+		History: toProtoRatingHistory(output.History),
+	}, nil
+}
+
+func (c *UserController) RefundOpponents(
+	ctx context.Context,
+	req *userproto.RefundOpponentsRequest,
+) (*userproto.RefundOpponentsResponse, error) {
+	output, err := c.useCases.RefundOpponents.Execute(ctx, &dto.RefundOpponentsInputDTO{
+		CheaterID: req.GetCheaterId(),
+		GameIDs:   req.GetGameIds(),
+		ActorID:   req.GetActorId(),
+		Note:      req.GetNote(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &userproto.RefundOpponentsResponse{
+		Refunds:        make([]*userproto.RatingHistory, 0, len(output.Refunds)),
+		SkippedGameIds: output.SkippedGameIDs,
+	}
+
+	for _, h := range output.Refunds {
+		resp.Refunds = append(resp.Refunds, toProtoRatingHistory(h))
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,153 @@
+package grpccontrollers
+
+import (
+	"context"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	userproto "github.com/EugeneTsydenov/chesshub-user-service/internal/controllers/grpccontrollers/genproto"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+)
+
+func (c *UserController) GetRatings(ctx context.Context, req *userproto.GetRatingsRequest) (*userproto.GetRatingsResponse, error) {
+	output, err := c.useCases.GetRatings.Execute(ctx, &dto.GetRatingsInputDTO{
+		UserID:       req.GetUserId(),
+		TimeControl:  string(fromProtoTimeControl(req.GetTimeControl())),
+		HistoryLimit: int(req.GetHistoryLimit()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &userproto.GetRatingsResponse{
+		Ratings: make([]*userproto.Rating, 0, len(output.Ratings)),
+		History: make([]*userproto.RatingHistory, 0, len(output.History)),
+	}
+
+	for _, r := range output.Ratings {
+		resp.Ratings = append(resp.Ratings, toProtoRating(r))
+	}
+
+	for _, h := range output.History {
+		resp.History = append(resp.History, toProtoRatingHistory(h))
+	}
+
+	return resp, nil
+}
+
+func (c *UserController) GetRatingChart(
+	ctx context.Context,
+	req *userproto.GetRatingChartRequest,
+) (*userproto.GetRatingChartResponse, error) {
+	output, err := c.useCases.GetRatingChart.Execute(ctx, &dto.GetRatingChartInputDTO{
+		UserID:      req.GetUserId(),
+		TimeControl: string(fromProtoTimeControl(req.GetTimeControl())),
+		Interval:    string(fromProtoChartInterval(req.GetInterval())),
+		From:        toOptionalTime(req.GetFrom()),
+		To:          toOptionalTime(req.GetTo()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &userproto.GetRatingChartResponse{
+		Interval: chartIntervals[enums.ChartInterval(output.Interval)],
+		Buckets:  make([]*userproto.RatingBucket, 0, len(output.Buckets)),
+	}
+
+	for _, b := range output.Buckets {
+		resp.Buckets = append(resp.Buckets, toProtoRatingBucket(b))
+	}
+
+	return resp, nil
+}
+
+func (c *UserController) GetLeaderboard(
+	ctx context.Context,
+	req *userproto.GetLeaderboardRequest,
+) (*userproto.GetLeaderboardResponse, error) {
+	input := &dto.GetLeaderboardInputDTO{
+		TimeControl: string(fromProtoTimeControl(req.GetTimeControl())),
+		Limit:       int(req.GetLimit()),
+	}
+
+	if after := req.GetAfter(); after != nil {
+		input.After = &user.LeaderboardCursor{
+			Rating: int(after.GetRating()),
+			UserID: after.GetUserId(),
+		}
+	}
+
+	output, err := c.useCases.GetLeaderboard.Execute(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &userproto.GetLeaderboardResponse{
+		Entries: toProtoLeaderboardEntries(output.Entries),
+	}
+
+	if output.Next != nil {
+		resp.Next = &userproto.LeaderboardCursor{
+			Rating: int32(output.Next.Rating),
+			UserId: output.Next.UserID,
+		}
+	}
+
+	return resp, nil
+}
+
+func (c *UserController) RecordGameResult(
+	ctx context.Context,
+	req *userproto.RecordGameResultRequest,
+) (*userproto.RecordGameResultResponse, error) {
+	output, err := c.useCases.RecordGameResult.Execute(ctx, &dto.RecordGameResultInputDTO{
+		GameID:      req.GetGameId(),
+		WhiteUserID: req.GetWhiteUserId(),
+		BlackUserID: req.GetBlackUserId(),
+		TimeControl: string(fromProtoTimeControl(req.GetTimeControl())),
+		Outcome:     string(gameOutcomes[req.GetOutcome()]),
+		FinishedAt:  toOptionalTime(req.GetFinishedAt()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &userproto.RecordGameResultResponse{
+		White:           toProtoRating(output.White),
+		Black:           toProtoRating(output.Black),
+		AlreadyRecorded: output.AlreadyRecorded,
+	}, nil
+}
+
+func (c *UserController) GetLeaderboardAroundUser(
+	ctx context.Context,
+	req *userproto.GetLeaderboardAroundUserRequest,
+) (*userproto.GetLeaderboardAroundUserResponse, error) {
+	output, err := c.useCases.GetLeaderboardAroundUser.Execute(ctx, &dto.GetLeaderboardAroundUserInputDTO{
+		UserID:      req.GetUserId(),
+		TimeControl: string(fromProtoTimeControl(req.GetTimeControl())),
+		Radius:      int(req.GetRadius()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &userproto.GetLeaderboardAroundUserResponse{
+		Entries: toProtoLeaderboardEntries(output.Entries),
+	}, nil
+}
+
+func (c *UserController) GetUserRank(ctx context.Context, req *userproto.GetUserRankRequest) (*userproto.GetUserRankResponse, error) {
+	output, err := c.useCases.GetUserRank.Execute(ctx, &dto.GetUserRankInputDTO{
+		UserID:      req.GetUserId(),
+		TimeControl: string(fromProtoTimeControl(req.GetTimeControl())),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &userproto.GetUserRankResponse{
+		Rank: int32(output.Rank),
+	}, nil
+}
@@ -0,0 +1,71 @@
+package grpccontrollers
+
+import (
+	"context"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	userproto "github.com/EugeneTsydenov/chesshub-user-service/internal/controllers/grpccontrollers/genproto"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+)
+
+func (c *UserController) StartSeason(
+	ctx context.Context,
+	req *userproto.StartSeasonRequest,
+) (*userproto.StartSeasonResponse, error) {
+	output, err := c.useCases.StartSeason.Execute(ctx, &dto.StartSeasonInputDTO{
+		Name: req.GetName(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &userproto.StartSeasonResponse{
+		Season: toProtoSeason(output.Season),
+	}
+
+	if output.Ended != nil {
+		resp.Ended = toProtoSeason(output.Ended)
+	}
+
+	return resp, nil
+}
+
+func (c *UserController) GetSeasonStandings(
+	ctx context.Context,
+	req *userproto.GetSeasonStandingsRequest,
+) (*userproto.GetSeasonStandingsResponse, error) {
+	input := &dto.GetSeasonStandingsInputDTO{
+		SeasonID:    req.GetSeasonId(),
+		TimeControl: string(fromProtoTimeControl(req.GetTimeControl())),
+		Limit:       int(req.GetLimit()),
+	}
+
+	if after := req.GetAfter(); after != nil {
+		input.After = &user.LeaderboardCursor{
+			Rating: int(after.GetRating()),
+			UserID: after.GetUserId(),
+		}
+	}
+
+	output, err := c.useCases.GetSeasonStandings.Execute(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &userproto.GetSeasonStandingsResponse{
+		Standings: make([]*userproto.SeasonStanding, 0, len(output.Standings)),
+	}
+
+	for _, s := range output.Standings {
+		resp.Standings = append(resp.Standings, toProtoSeasonStanding(s))
+	}
+
+	if output.Next != nil {
+		resp.Next = &userproto.LeaderboardCursor{
+			Rating: int32(output.Next.Rating),
+			UserId: output.Next.UserID,
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,217 @@
+package grpccontrollers
+
+import (
+	"context"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/dto"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/usecase"
+	userproto "github.com/EugeneTsydenov/chesshub-user-service/internal/controllers/grpccontrollers/genproto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type UseCases struct {
+	RegisterUser             usecase.RegisterUser
+	VerifyCredentials        usecase.VerifyCredentials
+	SendEmailVerification    usecase.SendEmailVerification
+	ConfirmEmail             usecase.ConfirmEmail
+	RequestPasswordReset     usecase.RequestPasswordReset
+	ConfirmPasswordReset     usecase.ConfirmPasswordReset
+	ChangePassword           usecase.ChangePassword
+	RecordGameResult         usecase.RecordGameResult
+	GetRatings               usecase.GetRatings
+	GetLeaderboard           usecase.GetLeaderboard
+	GetLeaderboardAroundUser usecase.GetLeaderboardAroundUser
+	GetUserRank              usecase.GetUserRank
+	GetPublicProfiles        usecase.GetPublicProfiles
+	UpdateProfile            usecase.UpdateProfile
+	SuspendUser              usecase.SuspendUser
+	BanUser                  usecase.BanUser
+	ReinstateUser            usecase.ReinstateUser
+	GetModerationLog         usecase.GetModerationLog
+	DeleteUser               usecase.DeleteUser
+	RestoreUser              usecase.RestoreUser
+	ExportUserData           usecase.ExportUserData
+	GrantPremium             usecase.GrantPremium
+	RevokePremium            usecase.RevokePremium
+	GetPremiumStatus         usecase.GetPremiumStatus
+	GetUser                  usecase.GetUser
+	UpdateUser               usecase.UpdateUser
+	GetProfile               usecase.GetProfile
+	GetUserByTag             usecase.GetUserByTag
+	ChangeTag                usecase.ChangeTag
+	GetRatingChart           usecase.GetRatingChart
+	AdjustRating             usecase.AdjustRating
+	PenalizeRating           usecase.PenalizeRating
+	RefundOpponents          usecase.RefundOpponents
+	StartSeason              usecase.StartSeason
+	GetSeasonStandings       usecase.GetSeasonStandings
+	GetHeadToHead            usecase.GetHeadToHead
+	GetTopOpponents          usecase.GetTopOpponents
+}
+
+type UserController struct {
+	userproto.UnimplementedUserServiceServer
+
+	useCases *UseCases
+}
+
+func NewUserController(useCases *UseCases) *UserController {
+	return &UserController{
+		useCases: useCases,
+	}
+}
+
+func (c *UserController) RegisterUser(ctx context.Context, req *userproto.RegisterUserRequest) (*userproto.RegisterUserResponse, error) {
+	output, err := c.useCases.RegisterUser.Execute(ctx, &dto.RegisterUserInputDTO{
+		Email:       req.GetEmail(),
+		PublicName:  req.GetPublicName(),
+		Password:    req.GetPassword(),
+		CountryCode: req.GetCountryCode(),
+		Language:    req.GetLanguage(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &userproto.RegisterUserResponse{
+		Message: output.Message,
+	}, nil
+}
+
+func (c *UserController) VerifyCredentials(
+	ctx context.Context,
+	req *userproto.VerifyCredentialsRequest,
+) (*userproto.VerifyCredentialsResponse, error) {
+	output, err := c.useCases.VerifyCredentials.Execute(ctx, &dto.VerifyCredentialsInputDTO{
+		Email:    req.GetEmail(),
+		Tag:      req.GetTag(),
+		Password: req.GetPassword(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &userproto.VerifyCredentialsResponse{
+		User: toProtoUser(output.User),
+	}, nil
+}
+
+func (c *UserController) SendEmailVerification(
+	ctx context.Context,
+	req *userproto.SendEmailVerificationRequest,
+) (*userproto.SendEmailVerificationResponse, error) {
+	output, err := c.useCases.SendEmailVerification.Execute(ctx, &dto.SendEmailVerificationInputDTO{
+		UserID: req.GetUserId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &userproto.SendEmailVerificationResponse{
+		Message: output.Message,
+	}, nil
+}
+
+func (c *UserController) ConfirmEmail(
+	ctx context.Context,
+	req *userproto.ConfirmEmailRequest,
+) (*userproto.ConfirmEmailResponse, error) {
+	output, err := c.useCases.ConfirmEmail.Execute(ctx, &dto.ConfirmEmailInputDTO{
+		Token: req.GetToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &userproto.ConfirmEmailResponse{
+		User: toProtoUser(output.User),
+	}, nil
+}
+
+func (c *UserController) GetUser(ctx context.Context, req *userproto.GetUserRequest) (*userproto.GetUserResponse, error) {
+	output, err := c.useCases.GetUser.Execute(ctx, &dto.GetUserInputDTO{
+		UserID: req.GetId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &userproto.GetUserResponse{
+		User: toProtoUser(output.User),
+	}, nil
+}
+
+func (c *UserController) GetUserByTag(ctx context.Context, req *userproto.GetUserByTagRequest) (*userproto.GetUserResponse, error) {
+	output, err := c.useCases.GetUserByTag.Execute(ctx, &dto.GetUserByTagInputDTO{
+		Tag: req.GetTag(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &userproto.GetUserResponse{
+		User: toProtoUser(output.User),
+	}
+
+	if output.RedirectedFrom != "" {
+		resp.RedirectedFrom = &output.RedirectedFrom
+	}
+
+	return resp, nil
+}
+
+func (c *UserController) UpdateUser(ctx context.Context, req *userproto.UpdateUserRequest) (*userproto.UpdateUserResponse, error) {
+	output, err := c.useCases.UpdateUser.Execute(ctx, &dto.UpdateUserInputDTO{
+		UserID:   req.GetId(),
+		Email:    req.Email,
+		Language: req.Language,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &userproto.UpdateUserResponse{
+		User: toProtoUser(output.User),
+	}, nil
+}
+
+func (c *UserController) ChangeTag(ctx context.Context, req *userproto.ChangeTagRequest) (*userproto.ChangeTagResponse, error) {
+	output, err := c.useCases.ChangeTag.Execute(ctx, &dto.ChangeTagInputDTO{
+		UserID: req.GetUserId(),
+		Tag:    req.GetTag(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &userproto.ChangeTagResponse{
+		User: toProtoUser(output.User),
+	}, nil
+}
+
+func (c *UserController) DeleteUser(ctx context.Context, req *userproto.DeleteUserRequest) (*userproto.DeleteUserResponse, error) {
+	output, err := c.useCases.DeleteUser.Execute(ctx, &dto.DeleteUserInputDTO{
+		UserID: req.GetId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &userproto.DeleteUserResponse{
+		Message:     output.Message,
+		GracePeriod: durationpb.New(output.GracePeriod),
+	}, nil
+}
+
+func (c *UserController) RestoreUser(ctx context.Context, req *userproto.RestoreUserRequest) (*userproto.RestoreUserResponse, error) {
+	output, err := c.useCases.RestoreUser.Execute(ctx, &dto.RestoreUserInputDTO{
+		UserID: req.GetId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &userproto.RestoreUserResponse{
+		User: toProtoUser(output.User),
+	}, nil
+}
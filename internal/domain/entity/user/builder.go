@@ -21,6 +21,9 @@ type Builder struct {
 	isPremium       bool
 	emailVerifiedAt *time.Time
 	premiumUntil    *time.Time
+	suspendedUntil  *time.Time
+	deletedAt       *time.Time
+	anonymizedAt    *time.Time
 	language        string
 	lastActiveAt    time.Time
 	lastLoginAt     *time.Time
@@ -86,6 +89,24 @@ func (b *Builder) WithPremiumUntil(premiumUntil *time.Time) *Builder {
 	return b
 }
 
+func (b *Builder) WithSuspendedUntil(suspendedUntil *time.Time) *Builder {
+	b.suspendedUntil = suspendedUntil
+
+	return b
+}
+
+func (b *Builder) WithDeletedAt(deletedAt *time.Time) *Builder {
+	b.deletedAt = deletedAt
+
+	return b
+}
+
+func (b *Builder) WithAnonymizedAt(anonymizedAt *time.Time) *Builder {
+	b.anonymizedAt = anonymizedAt
+
+	return b
+}
+
 func (b *Builder) WithLanguage(language string) *Builder {
 	b.language = language
 
@@ -127,6 +148,9 @@ func (b *Builder) Build() *User {
 		isPremium:       b.isPremium,
 		emailVerifiedAt: b.emailVerifiedAt,
 		premiumUntil:    b.premiumUntil,
+		suspendedUntil:  b.suspendedUntil,
+		deletedAt:       b.deletedAt,
+		anonymizedAt:    b.anonymizedAt,
 		language:        b.language,
 		lastActiveAt:    b.lastActiveAt,
 		lastLoginAt:     b.lastLoginAt,
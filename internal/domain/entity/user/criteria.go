@@ -1,15 +1,29 @@
 package user
 
-import "time"
+import (
+	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+)
 
 type Criteria struct {
-	Tag              *string
-	Email            *string
-	PublicName       *string
-	LastActiveAfter  *time.Time
-	LastActiveBefore *time.Time
-	UpdatedAfter     *time.Time
-	UpdatedBefore    *time.Time
-	CreatedAfter     *time.Time
-	CreatedBefore    *time.Time
+	// IDAfter skips users up to and including the id, to page through users ordered by id.
+	IDAfter              *int64
+	Status               *enums.UserStatus
+	SuspendedUntilBefore *time.Time
+	DeletedBefore        *time.Time
+	Anonymized           *bool
+	IsPremium            *bool
+	PremiumUntilBefore   *time.Time
+	Tag                  *string
+	Email                *string
+	PublicName           *string
+	LastActiveAfter      *time.Time
+	LastActiveBefore     *time.Time
+	UpdatedAfter         *time.Time
+	UpdatedBefore        *time.Time
+	CreatedAfter         *time.Time
+	CreatedBefore        *time.Time
+	// Limit caps the number of users returned, ordered by id, when positive.
+	Limit uint64
 }
@@ -0,0 +1,59 @@
+package user
+
+import (
+	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+)
+
+// HeadToHead is the record of a user against one opponent in a time control, counted from the user's side. Every
+// pair is kept twice, once from each side.
+type HeadToHead struct {
+	UserID      int64
+	OpponentID  int64
+	TimeControl enums.TimeControl
+	Wins        int
+	Losses      int
+	Draws       int
+	LastGameAt  time.Time
+}
+
+// HeadToHeadOfGame returns the records of a single game from the side of white and black.
+func HeadToHeadOfGame(
+	whiteID, blackID int64,
+	timeControl enums.TimeControl,
+	outcome enums.GameOutcome,
+	playedAt time.Time,
+) (*HeadToHead, *HeadToHead, error) {
+	white := &HeadToHead{UserID: whiteID, OpponentID: blackID, TimeControl: timeControl, LastGameAt: playedAt}
+	black := &HeadToHead{UserID: blackID, OpponentID: whiteID, TimeControl: timeControl, LastGameAt: playedAt}
+
+	switch outcome {
+	case enums.GameOutcomeWhiteWins:
+		white.Wins, black.Losses = 1, 1
+	case enums.GameOutcomeBlackWins:
+		white.Losses, black.Wins = 1, 1
+	case enums.GameOutcomeDraw:
+		white.Draws, black.Draws = 1, 1
+	default:
+		return nil, nil, domainerrors.ErrInvalidRatingChange
+	}
+
+	return white, black, nil
+}
+
+func (h *HeadToHead) Games() int {
+	return h.Wins + h.Losses + h.Draws
+}
+
+// Merge adds the games of other, a record of the same pair, keeping the latest game time.
+func (h *HeadToHead) Merge(other *HeadToHead) {
+	h.Wins += other.Wins
+	h.Losses += other.Losses
+	h.Draws += other.Draws
+
+	if other.LastGameAt.After(h.LastGameAt) {
+		h.LastGameAt = other.LastGameAt
+	}
+}
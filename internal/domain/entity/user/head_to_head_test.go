@@ -0,0 +1,46 @@
+package user
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+)
+
+func TestHeadToHeadOfGame(t *testing.T) {
+	playedAt := time.Now()
+
+	t.Run("should count the game from both sides", func(t *testing.T) {
+		white, black, err := HeadToHeadOfGame(1, 2, enums.TimeControlBlitz, enums.GameOutcomeWhiteWins, playedAt)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(2), white.OpponentID)
+		assert.Equal(t, 1, white.Wins)
+		assert.Equal(t, int64(1), black.OpponentID)
+		assert.Equal(t, 1, black.Losses)
+		assert.Equal(t, 1, black.Games())
+	})
+
+	t.Run("should merge records keeping the latest game", func(t *testing.T) {
+		first, _, err := HeadToHeadOfGame(1, 2, enums.TimeControlBlitz, enums.GameOutcomeDraw, playedAt)
+		require.NoError(t, err)
+
+		second, _, err := HeadToHeadOfGame(1, 2, enums.TimeControlRapid, enums.GameOutcomeBlackWins, playedAt.Add(time.Hour))
+		require.NoError(t, err)
+
+		first.Merge(second)
+		assert.Equal(t, 2, first.Games())
+		assert.Equal(t, 1, first.Draws)
+		assert.Equal(t, 1, first.Losses)
+		assert.Equal(t, second.LastGameAt, first.LastGameAt)
+	})
+
+	t.Run("should reject unknown outcomes", func(t *testing.T) {
+		_, _, err := HeadToHeadOfGame(1, 2, enums.TimeControlBlitz, "aborted", playedAt)
+		require.ErrorIs(t, err, domainerrors.ErrInvalidRatingChange)
+	})
+}
@@ -0,0 +1,40 @@
+package user
+
+type LeaderboardEntry struct {
+	Rank   int
+	Rating *Rating
+}
+
+// LeaderboardCursor points at the last entry of a page. Leaderboards are ordered by rating desc, user id asc.
+type LeaderboardCursor struct {
+	Rating int
+	UserID int64
+}
+
+func (e *LeaderboardEntry) Cursor() *LeaderboardCursor {
+	return &LeaderboardCursor{
+		Rating: e.Rating.Rating,
+		UserID: e.Rating.UserID,
+	}
+}
+
+// RankLeaderboard assigns competition ranks to a leaderboard page given how many players are rated above its first
+// entry and how many entries precede it: players with equal ratings share a rank.
+func RankLeaderboard(ratings []*Rating, above, before int) []*LeaderboardEntry {
+	entries := make([]*LeaderboardEntry, 0, len(ratings))
+
+	rank := above + 1
+
+	for i, rating := range ratings {
+		if i > 0 && rating.Rating != ratings[i-1].Rating {
+			rank = before + i + 1
+		}
+
+		entries = append(entries, &LeaderboardEntry{
+			Rank:   rank,
+			Rating: rating,
+		})
+	}
+
+	return entries
+}
@@ -0,0 +1,40 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRankLeaderboard(t *testing.T) {
+	ratings := func(values ...int) []*Rating {
+		result := make([]*Rating, 0, len(values))
+		for i, v := range values {
+			result = append(result, &Rating{UserID: int64(i + 1), Rating: v})
+		}
+
+		return result
+	}
+
+	ranks := func(entries []*LeaderboardEntry) []int {
+		result := make([]int, 0, len(entries))
+		for _, e := range entries {
+			result = append(result, e.Rank)
+		}
+
+		return result
+	}
+
+	t.Run("should share ranks between equal ratings on the first page", func(t *testing.T) {
+		entries := RankLeaderboard(ratings(2000, 1900, 1900, 1800), 0, 0)
+
+		assert.Equal(t, []int{1, 2, 2, 4}, ranks(entries))
+	})
+
+	t.Run("should continue a tie that started on the previous page", func(t *testing.T) {
+		// Two players with 1900 are above the page start, the page starts at the third 1900.
+		entries := RankLeaderboard(ratings(1900, 1900, 1700), 3, 5)
+
+		assert.Equal(t, []int{4, 4, 8}, ranks(entries))
+	})
+}
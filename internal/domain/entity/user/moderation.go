@@ -0,0 +1,19 @@
+package user
+
+import (
+	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+)
+
+// ModerationRecord is an entry of the moderation log, written for every status change made by moderation.
+type ModerationRecord struct {
+	ID     int64
+	UserID int64
+	Action enums.ModerationAction
+	// ActorID is the moderator who took the action, nil when the system did, e.g. when a suspension ran out.
+	ActorID        *int64
+	Reason         string
+	SuspendedUntil *time.Time
+	CreatedAt      time.Time
+}
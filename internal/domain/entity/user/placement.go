@@ -0,0 +1,49 @@
+package user
+
+import "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+
+// Placement is the period in which a rating is provisional: its first games in a time control. Provisional
+// ratings move faster and stay off the leaderboards until placement is over.
+type Placement struct {
+	games      map[enums.TimeControl]int
+	volatility float64
+}
+
+// NewPlacement takes the number of placement games per time control, time controls left out have none, and the
+// volatility provisional ratings are rated with at the least.
+func NewPlacement(games map[enums.TimeControl]int, volatility float64) *Placement {
+	return &Placement{
+		games:      games,
+		volatility: volatility,
+	}
+}
+
+// Games returns the number of placement games of the time control. A nil placement has none.
+func (p *Placement) Games(timeControl enums.TimeControl) int {
+	if p == nil {
+		return 0
+	}
+
+	return p.games[timeControl]
+}
+
+// IsProvisional reports whether the rating has not played its placement games yet.
+func (p *Placement) IsProvisional(r *Rating) bool {
+	return r.GamesPlayed < p.Games(r.TimeControl)
+}
+
+// Volatility returns the volatility the next game of the rating is rated with.
+func (p *Placement) Volatility(r *Rating) float64 {
+	if p.IsProvisional(r) {
+		return max(r.Volatility, p.volatility)
+	}
+
+	return r.Volatility
+}
+
+// Mark sets the provisional flag of the ratings from their game count.
+func (p *Placement) Mark(ratings ...*Rating) {
+	for _, r := range ratings {
+		r.Provisional = p.IsProvisional(r)
+	}
+}
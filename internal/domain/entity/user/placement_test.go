@@ -0,0 +1,39 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+)
+
+func TestPlacement(t *testing.T) {
+	placement := NewPlacement(map[enums.TimeControl]int{enums.TimeControlBlitz: 10}, 0.09)
+
+	t.Run("should keep ratings provisional until placement games are played", func(t *testing.T) {
+		r := &Rating{TimeControl: enums.TimeControlBlitz, GamesPlayed: 9, Volatility: 0.06}
+
+		placement.Mark(r)
+		assert.True(t, r.Provisional)
+		assert.InDelta(t, 0.09, placement.Volatility(r), 0)
+
+		r.GamesPlayed = 10
+
+		placement.Mark(r)
+		assert.False(t, r.Provisional)
+		assert.InDelta(t, 0.06, placement.Volatility(r), 0)
+	})
+
+	t.Run("should not make ratings provisional without placement games", func(t *testing.T) {
+		r := &Rating{TimeControl: enums.TimeControlRapid}
+
+		placement.Mark(r)
+		assert.False(t, r.Provisional)
+
+		var none *Placement
+
+		none.Mark(r)
+		assert.False(t, r.Provisional)
+	})
+}
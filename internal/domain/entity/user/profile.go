@@ -1,8 +1,10 @@
 package user
 
 import (
-	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/publicname"
+	"strconv"
 	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/publicname"
 )
 
 type Profile struct {
@@ -29,3 +31,52 @@ func (p *Profile) Initialize(userID int64) {
 	p.IsPublic = true
 	p.ShowCountry = true
 }
+
+// PublicView returns the profile as other users may see it. Private profiles only expose the name and avatar
+// needed to render the player, hidden countries are dropped together with the city.
+func (p *Profile) PublicView() *Profile {
+	view := &Profile{
+		UserID:      p.UserID,
+		PublicName:  p.PublicName,
+		AvatarURL:   p.AvatarURL,
+		IsPublic:    p.IsPublic,
+		ShowCountry: p.ShowCountry,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+	}
+
+	if !p.IsPublic {
+		return view
+	}
+
+	if p.ShowCountry {
+		view.CountryCode = p.CountryCode
+		view.City = p.City
+	}
+
+	view.Bio = p.Bio
+	view.BirthDate = p.BirthDate
+	view.CoverImageURL = p.CoverImageURL
+	view.WebsiteURL = p.WebsiteURL
+	view.TwitchUsername = p.TwitchUsername
+	view.YoutubeChannelURL = p.YoutubeChannelURL
+
+	return view
+}
+
+// Anonymize replaces the public name with one derived from the user id and clears every other personal field.
+func (p *Profile) Anonymize() error {
+	publicName, err := publicname.New("deleted_" + strconv.FormatInt(p.UserID, 36))
+	if err != nil {
+		return err
+	}
+
+	*p = Profile{
+		UserID:     p.UserID,
+		PublicName: publicName,
+		CreatedAt:  p.CreatedAt,
+		UpdatedAt:  p.UpdatedAt,
+	}
+
+	return nil
+}
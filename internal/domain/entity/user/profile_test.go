@@ -0,0 +1,76 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfile_PublicView(t *testing.T) {
+	text := func(s string) *string { return &s }
+
+	newProfile := func(isPublic, showCountry bool) *Profile {
+		return &Profile{
+			UserID:      1,
+			Bio:         text("bio"),
+			CountryCode: text("DE"),
+			City:        text("Berlin"),
+			AvatarURL:   text("https://example.com/avatar.png"),
+			WebsiteURL:  text("https://example.com"),
+			IsPublic:    isPublic,
+			ShowCountry: showCountry,
+		}
+	}
+
+	t.Run("should keep every field of a public profile", func(t *testing.T) {
+		p := newProfile(true, true)
+
+		assert.Equal(t, p, p.PublicView())
+	})
+
+	t.Run("should hide location when country is hidden", func(t *testing.T) {
+		view := newProfile(true, false).PublicView()
+
+		assert.Nil(t, view.CountryCode)
+		assert.Nil(t, view.City)
+		assert.NotNil(t, view.Bio)
+	})
+
+	t.Run("should expose only name and avatar of a private profile", func(t *testing.T) {
+		view := newProfile(false, true).PublicView()
+
+		assert.Equal(t, int64(1), view.UserID)
+		assert.NotNil(t, view.AvatarURL)
+		assert.Nil(t, view.CountryCode)
+		assert.Nil(t, view.City)
+		assert.Nil(t, view.Bio)
+		assert.Nil(t, view.WebsiteURL)
+	})
+}
+
+func TestProfile_Anonymize(t *testing.T) {
+	t.Run("should keep only a generated public name", func(t *testing.T) {
+		text := func(s string) *string { return &s }
+
+		p := &Profile{
+			UserID:      12345,
+			Bio:         text("bio"),
+			CountryCode: text("DE"),
+			City:        text("Berlin"),
+			WebsiteURL:  text("https://example.com"),
+			IsPublic:    true,
+			ShowCountry: true,
+		}
+
+		require.NoError(t, p.Anonymize())
+
+		assert.Equal(t, "deleted_9ix", p.PublicName.Value())
+		assert.Equal(t, int64(12345), p.UserID)
+		assert.Nil(t, p.Bio)
+		assert.Nil(t, p.CountryCode)
+		assert.Nil(t, p.City)
+		assert.Nil(t, p.WebsiteURL)
+		assert.False(t, p.IsPublic)
+	})
+}
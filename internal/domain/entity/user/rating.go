@@ -1,14 +1,21 @@
 package user
 
 import (
+	"time"
+
 	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
 	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
 	"github.com/google/uuid"
-	"time"
 )
 
 const (
-	defaultRating = 1200
+	defaultRating     = 1200
+	defaultDeviation  = 350
+	defaultVolatility = 0.06
+
+	// MinRating and MaxRating bound the ratings that can be set outside of games.
+	MinRating = 100
+	MaxRating = 4000
 )
 
 type Rating struct {
@@ -16,6 +23,8 @@ type Rating struct {
 	UserID       int64
 	TimeControl  enums.TimeControl
 	Rating       int
+	Deviation    float64
+	Volatility   float64
 	PeakRating   int
 	LowestRating int
 	GamesPlayed  int
@@ -25,6 +34,9 @@ type Rating struct {
 	LastGameAt   *time.Time
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
+	// Provisional is set while the rating is in its placement period. It is derived from GamesPlayed, see
+	// Placement, and is not stored.
+	Provisional bool
 }
 
 func (r *Rating) Initialize(userID int64, tc enums.TimeControl) error {
@@ -37,6 +49,8 @@ func (r *Rating) Initialize(userID int64, tc enums.TimeControl) error {
 	r.TimeControl = tc
 
 	r.Rating = defaultRating
+	r.Deviation = defaultDeviation
+	r.Volatility = defaultVolatility
 	r.PeakRating = defaultRating
 	r.LowestRating = defaultRating
 
@@ -53,3 +67,53 @@ func (r *Rating) GenerateID() error {
 
 	return nil
 }
+
+// ApplyGame stores the outcome of a finished game. Score is 1 for a win, 0.5 for a draw and 0 for a loss.
+func (r *Rating) ApplyGame(rating int, deviation, volatility, score float64, playedAt time.Time) {
+	r.Rating = rating
+	r.Deviation = deviation
+	r.Volatility = volatility
+
+	r.PeakRating = max(r.PeakRating, rating)
+	r.LowestRating = min(r.LowestRating, rating)
+
+	switch {
+	case score > 0.5:
+		r.Wins++
+	case score < 0.5:
+		r.Losses++
+	default:
+		r.Draws++
+	}
+
+	r.GamesPlayed++
+	r.LastGameAt = &playedAt
+}
+
+// Adjust sets the rating outside of a game. Deviation, volatility and game counts are kept.
+func (r *Rating) Adjust(rating int) error {
+	if rating < MinRating || rating > MaxRating {
+		return domainerrors.ErrInvalidRatingChange
+	}
+
+	r.Rating = rating
+	r.PeakRating = max(r.PeakRating, rating)
+	r.LowestRating = min(r.LowestRating, rating)
+
+	return nil
+}
+
+// ResetForSeason sets the rating and deviation for a new season. Volatility and game counts are kept.
+func (r *Rating) ResetForSeason(rating int, deviation float64) error {
+	if deviation <= 0 {
+		return domainerrors.ErrInvalidRatingChange
+	}
+
+	if err := r.Adjust(rating); err != nil {
+		return err
+	}
+
+	r.Deviation = deviation
+
+	return nil
+}
@@ -0,0 +1,14 @@
+package user
+
+import "time"
+
+// RatingBucket summarises the rating changes of one time control within a day, week or month. Open is the rating
+// before the first change in the bucket, Close the rating after the last one. Games counts the changes made by games.
+type RatingBucket struct {
+	Start time.Time
+	Open  int
+	Close int
+	Min   int
+	Max   int
+	Games int
+}
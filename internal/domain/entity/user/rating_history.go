@@ -15,5 +15,14 @@ type RatingHistory struct {
 	RatingRange  int
 	GameID       *uuid.UUID
 	ChangeReason enums.ChangeReason
-	CreatedAt    time.Time
+	// ActorID is the admin who changed the rating by hand, nil for changes made by games.
+	ActorID   *int64
+	Note      string
+	CreatedAt time.Time
+}
+
+// RatingHistoryCursor points at the last entry of a page of a user's whole rating history.
+type RatingHistoryCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
 }
@@ -0,0 +1,46 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+)
+
+func TestRating_Adjust(t *testing.T) {
+	newRating := func() *Rating {
+		return &Rating{Rating: 1500, PeakRating: 1600, LowestRating: 1400, Deviation: 80, GamesPlayed: 12}
+	}
+
+	t.Run("should set the rating and keep the rest", func(t *testing.T) {
+		r := newRating()
+
+		require.NoError(t, r.Adjust(1450))
+
+		assert.Equal(t, 1450, r.Rating)
+		assert.Equal(t, 1600, r.PeakRating)
+		assert.Equal(t, 1400, r.LowestRating)
+		assert.Equal(t, 80.0, r.Deviation)
+		assert.Equal(t, 12, r.GamesPlayed)
+	})
+
+	t.Run("should move peak and lowest rating", func(t *testing.T) {
+		r := newRating()
+
+		require.NoError(t, r.Adjust(1700))
+		assert.Equal(t, 1700, r.PeakRating)
+
+		require.NoError(t, r.Adjust(1300))
+		assert.Equal(t, 1300, r.LowestRating)
+	})
+
+	t.Run("should reject ratings out of bounds", func(t *testing.T) {
+		r := newRating()
+
+		assert.ErrorIs(t, r.Adjust(MinRating-1), domainerrors.ErrInvalidRatingChange)
+		assert.ErrorIs(t, r.Adjust(MaxRating+1), domainerrors.ErrInvalidRatingChange)
+		assert.Equal(t, 1500, r.Rating)
+	})
+}
@@ -2,6 +2,8 @@ package user
 
 import (
 	"context"
+	"time"
+
 	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
 	"github.com/google/uuid"
 )
@@ -9,30 +11,139 @@ import (
 type Repository interface {
 	Create(ctx context.Context, user *User) (*User, error)
 	GetByID(ctx context.Context, id int64) (*User, error)
-	GetByUsername(ctx context.Context, username string) (*User, error)
+	GetByTag(ctx context.Context, tag string) (*User, error)
 	GetByEmail(ctx context.Context, email string) (*User, error)
-	Update(ctx context.Context, user *User) error
-	Delete(ctx context.Context, id int64) error
+	// LockByID returns the user locked for the current transaction, so concurrent changes are applied in turn.
+	LockByID(ctx context.Context, id int64) (*User, error)
+	// Update stores every field of the user but the password, which only UpdatePassword changes.
+	Update(ctx context.Context, user *User) (*User, error)
+	// UpdateLogin writes only the login timestamps of the user, so it cannot undo concurrent changes to the rest.
+	UpdateLogin(ctx context.Context, user *User) error
+	// UpdatePassword writes only the password hash of the user.
+	UpdatePassword(ctx context.Context, user *User) error
+	Find(ctx context.Context, criteria *Criteria) ([]*User, error)
+}
+
+// ModerationRepository stores the moderation log.
+type ModerationRepository interface {
+	Create(ctx context.Context, record *ModerationRecord) error
+	// ListByUserID returns the newest records of the user first, all of them when limit is 0.
+	ListByUserID(ctx context.Context, userID int64, limit int) ([]*ModerationRecord, error)
+}
+
+// TagHistoryRepository keeps the tags users have changed away from.
+type TagHistoryRepository interface {
+	Create(ctx context.Context, alias *TagAlias) error
+	// GetActive returns the alias of the tag held at the instant, ErrUserNotFound when there is none.
+	GetActive(ctx context.Context, tag string, at time.Time) (*TagAlias, error)
+	// LastReleasedAt returns when the user last changed their tag, nil if they never did.
+	LastReleasedAt(ctx context.Context, userID int64) (*time.Time, error)
+}
 
-	Search(ctx context.Context, query string, limit, offset int) ([]*User, error)
-	GetActiveUsers(ctx context.Context, limit, offset int) ([]*User, error)
-	CheckUsernameAvailable(ctx context.Context, username string) (bool, error)
-	CheckEmailAvailable(ctx context.Context, email string) (bool, error)
+// SeasonRepository stores seasons, their archived standings and the progress of their soft resets.
+type SeasonRepository interface {
+	Create(ctx context.Context, season *Season) error
+	GetByID(ctx context.Context, id int64) (*Season, error)
+	// LockCurrent returns the season in progress locked for the current transaction, ErrSeasonNotFound when
+	// there is none.
+	LockCurrent(ctx context.Context) (*Season, error)
+	Update(ctx context.Context, season *Season) error
+	// ArchiveStandings stores the standings of the time control from the current ratings and returns the number
+	// of players and their mean rating. Provisional players, players without games and deleted and banned users
+	// are left out, as they are from the leaderboards.
+	ArchiveStandings(ctx context.Context, seasonID int64, timeControl enums.TimeControl) (int, float64, error)
+	// GetStandings pages through the archived standings, ordered like the leaderboard.
+	GetStandings(
+		ctx context.Context,
+		seasonID int64,
+		timeControl enums.TimeControl,
+		after *LeaderboardCursor,
+		limit int,
+	) ([]*SeasonStanding, error)
+	CreateReset(ctx context.Context, reset *SeasonReset) error
+	// ListPendingResets returns the resets that have not completed, oldest season first.
+	ListPendingResets(ctx context.Context) ([]*SeasonReset, error)
+	// LockReset returns the reset locked for the current transaction, so only one worker advances it at a time.
+	LockReset(ctx context.Context, seasonID int64, timeControl enums.TimeControl) (*SeasonReset, error)
+	UpdateReset(ctx context.Context, reset *SeasonReset) error
+}
+
+// HeadToHeadRepository aggregates game results per pair of players.
+type HeadToHeadRepository interface {
+	// Add adds the games of the records to the stored ones, keeping the latest game time.
+	Add(ctx context.Context, records ...*HeadToHead) error
+	// GetBetween returns the records of the user against the opponent per time control.
+	GetBetween(ctx context.Context, userID, opponentID int64) ([]*HeadToHead, error)
+	// ListOpponents returns the records against the opponents the user played most, skipping deleted users. An
+	// empty time control sums every time control into one record per opponent.
+	ListOpponents(ctx context.Context, userID int64, timeControl enums.TimeControl, limit int) ([]*HeadToHead, error)
+}
+
+// TokenRepository stores single-use tokens that resolve to the user they were issued for.
+type TokenRepository interface {
+	Save(ctx context.Context, token string, userID int64, ttl time.Duration) error
+	Consume(ctx context.Context, token string) (int64, error)
+	RevokeAll(ctx context.Context, userID int64) error
 }
 
 type ProfileRepository interface {
-	Create(ctx context.Context, user *Profile) (*Profile, error)
+	Create(ctx context.Context, profile *Profile) (*Profile, error)
 	GetByUserID(ctx context.Context, userID int64) (*Profile, error)
 	Update(ctx context.Context, profile *Profile) error
-	GetPublicProfiles(ctx context.Context, userIDs []uuid.UUID) ([]*Profile, error)
+	// GetPublicProfiles returns the public view of existing profiles in the order of userIDs, skipping unknown ids
+	// and deleted and banned users.
+	GetPublicProfiles(ctx context.Context, userIDs []int64) ([]*Profile, error)
 }
 
 type RatingRepository interface {
-	GetUserRatings(ctx context.Context, userID uuid.UUID) ([]*Rating, error)
-	GetUserRating(ctx context.Context, userID uuid.UUID, timeControl enums.TimeControl) (*Rating, error)
+	GetUserRatings(ctx context.Context, userID int64) ([]*Rating, error)
+	GetUserRating(ctx context.Context, userID int64, timeControl enums.TimeControl) (*Rating, error)
+	// LockUserRating returns the rating locked for the current transaction, creating a default one if missing.
+	LockUserRating(ctx context.Context, userID int64, timeControl enums.TimeControl) (*Rating, error)
+	// GetRatingsByUserIDs returns the existing ratings of userIDs in one time control, in no particular order.
+	GetRatingsByUserIDs(ctx context.Context, timeControl enums.TimeControl, userIDs []int64) ([]*Rating, error)
+	// ListRatings pages through the ratings of a time control ordered by user id, skipping deleted and banned users
+	// as they are left off the leaderboards.
+	ListRatings(ctx context.Context, timeControl enums.TimeControl, afterUserID int64, limit int) ([]*Rating, error)
+	// UpdateRating stores the rating and refreshes its UpdatedAt, which only grows between two updates.
 	UpdateRating(ctx context.Context, rating *Rating) error
 	CreateRatingHistory(ctx context.Context, history *RatingHistory) error
-	GetRatingHistory(ctx context.Context, userID uuid.UUID, timeControl enums.TimeControl, limit int) ([]*RatingHistory, error)
-	GetLeaderboard(ctx context.Context, timeControl enums.TimeControl, limit, offset int) ([]*Rating, error)
-	GetUserRank(ctx context.Context, userID uuid.UUID, timeControl enums.TimeControl) (int, error)
+	HasGameHistory(ctx context.Context, gameID uuid.UUID) (bool, error)
+	// GetGameHistory returns every entry recorded for the game, those of the game itself and later refunds.
+	GetGameHistory(ctx context.Context, gameID uuid.UUID) ([]*RatingHistory, error)
+	GetRatingHistory(ctx context.Context, userID int64, timeControl enums.TimeControl, limit int) ([]*RatingHistory, error)
+	// ListRatingHistory pages through the history of every time control of the user, oldest first.
+	ListRatingHistory(ctx context.Context, userID int64, after *RatingHistoryCursor, limit int) ([]*RatingHistory, error)
+	// GetRatingBuckets aggregates the history within [from, to) into UTC buckets, oldest first. Buckets without
+	// changes are left out.
+	GetRatingBuckets(
+		ctx context.Context,
+		userID int64,
+		timeControl enums.TimeControl,
+		interval enums.ChartInterval,
+		from, to time.Time,
+	) ([]*RatingBucket, error)
+	// GetRecentRatingBuckets returns the daily buckets of the last days, today included.
+	GetRecentRatingBuckets(ctx context.Context, userID int64, timeControl enums.TimeControl, days int) ([]*RatingBucket, error)
+	GetLeaderboard(
+		ctx context.Context,
+		timeControl enums.TimeControl,
+		limit int,
+		after *LeaderboardCursor,
+	) ([]*LeaderboardEntry, error)
+	GetUserRank(ctx context.Context, userID int64, timeControl enums.TimeControl) (int, error)
+}
+
+// Leaderboard keeps ratings ranked per time control for reads that are too expensive for the primary store.
+// Ranks are competition ranks: players with equal ratings share one. Entries only carry the user id, time control
+// and rating value.
+type Leaderboard interface {
+	// Update applies ratings unless a newer version of the same rating has already been applied. Provisional
+	// ratings are taken off the leaderboard.
+	Update(ctx context.Context, ratings ...*Rating) error
+	// Remove drops the user from every time control.
+	Remove(ctx context.Context, userID int64) error
+	Page(ctx context.Context, timeControl enums.TimeControl, after *LeaderboardCursor, limit int) ([]*LeaderboardEntry, error)
+	Around(ctx context.Context, timeControl enums.TimeControl, userID int64, radius int) ([]*LeaderboardEntry, error)
+	Rank(ctx context.Context, timeControl enums.TimeControl, userID int64) (int, error)
 }
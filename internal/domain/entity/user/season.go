@@ -0,0 +1,62 @@
+package user
+
+import (
+	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+)
+
+// Season is a rating period. Only one season is in progress at a time, ending it archives its standings and
+// queues a soft reset of every time control.
+type Season struct {
+	ID        int64
+	Name      string
+	StartedAt time.Time
+	EndedAt   *time.Time
+}
+
+func (s *Season) End(at time.Time) error {
+	if s.EndedAt != nil {
+		return domainerrors.ErrSeasonEnded
+	}
+
+	s.EndedAt = &at
+
+	return nil
+}
+
+// SeasonReset tracks the soft reset of one time control after its season ended. Ratings are reset in user id
+// order and LastUserID is the last one done, so the reset resumes where it stopped.
+type SeasonReset struct {
+	SeasonID    int64
+	SeasonName  string
+	TimeControl enums.TimeControl
+	// MeanRating is the mean rating of the archived standings, the value ratings are pulled toward.
+	MeanRating  float64
+	LastUserID  int64
+	CompletedAt *time.Time
+}
+
+// Advance records a chunk of reset ratings, completing the reset when it was the last one.
+func (r *SeasonReset) Advance(lastUserID int64, last bool, at time.Time) {
+	r.LastUserID = max(r.LastUserID, lastUserID)
+
+	if last {
+		r.CompletedAt = &at
+	}
+}
+
+// SeasonStanding is the final position of a player in one time control of an ended season.
+type SeasonStanding struct {
+	SeasonID    int64
+	TimeControl enums.TimeControl
+	UserID      int64
+	Rank        int
+	Rating      int
+	Deviation   float64
+	GamesPlayed int
+	Wins        int
+	Losses      int
+	Draws       int
+}
@@ -1,5 +1,13 @@
 package user
 
+import (
+	"context"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/tag"
+)
+
 type Service interface {
-	SecureUser() error
+	CreateUser(ctx context.Context, user *User, profile *Profile) (*User, *Profile, error)
+	// BuildUserTag parses rawTag, or generates a tag when it is nil, that is free for userID to take.
+	BuildUserTag(ctx context.Context, userID int64, rawTag *string) (*tag.Tag, error)
 }
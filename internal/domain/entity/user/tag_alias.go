@@ -0,0 +1,18 @@
+package user
+
+import "time"
+
+// TagAlias is a tag the user has given up. Until it expires the tag keeps resolving to the user and nobody else
+// can take it, so a released tag cannot be used to impersonate its previous owner.
+type TagAlias struct {
+	ID         int64
+	UserID     int64
+	Tag        string
+	ReleasedAt time.Time
+	ExpiresAt  time.Time
+}
+
+// ActiveAt reports whether the alias is still held at the instant.
+func (a *TagAlias) ActiveAt(instant time.Time) bool {
+	return instant.Before(a.ExpiresAt)
+}
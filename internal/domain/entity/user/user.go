@@ -1,9 +1,13 @@
 package user
 
 import (
-	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+
 	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/email"
 	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/password"
 	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/tag"
@@ -19,6 +23,9 @@ type User struct {
 	isPremium       bool
 	emailVerifiedAt *time.Time
 	premiumUntil    *time.Time
+	suspendedUntil  *time.Time
+	deletedAt       *time.Time
+	anonymizedAt    *time.Time
 	language        string
 	lastActiveAt    time.Time
 	lastLoginAt     *time.Time
@@ -62,6 +69,21 @@ func (u *User) PremiumUntil() *time.Time {
 	return u.premiumUntil
 }
 
+// SuspendedUntil is set while the user is suspended.
+func (u *User) SuspendedUntil() *time.Time {
+	return u.suspendedUntil
+}
+
+// DeletedAt is set while the account is deleted.
+func (u *User) DeletedAt() *time.Time {
+	return u.deletedAt
+}
+
+// AnonymizedAt is set once the personal data of a deleted account has been erased.
+func (u *User) AnonymizedAt() *time.Time {
+	return u.anonymizedAt
+}
+
 func (u *User) Language() string {
 	return u.language
 }
@@ -94,3 +116,300 @@ func (u *User) Initialize() {
 func (u *User) RefreshLastActiveAt() {
 	u.lastActiveAt = time.Now()
 }
+
+// EnsureCanLogin reports why the user is not allowed to sign in, if anything.
+func (u *User) EnsureCanLogin() error {
+	switch u.status {
+	case enums.UserStatusActive:
+		return nil
+	case enums.UserStatusSuspended:
+		// The suspension has run out but has not been lifted yet.
+		if u.SuspensionExpired(time.Now()) {
+			return nil
+		}
+
+		return domainerrors.ErrUserSuspended
+	case enums.UserStatusBanned:
+		return domainerrors.ErrUserBanned
+	default:
+		return domainerrors.ErrInvalidCredentials
+	}
+}
+
+// IsRanked reports whether the ratings of the user are shown on leaderboards, those of deleted and banned users
+// are not.
+func (u *User) IsRanked() bool {
+	return u.status != enums.UserStatusDeleted && u.status != enums.UserStatusBanned
+}
+
+// EnsureCanBeRated rejects rating changes of deleted users. Banned users keep a rating that can be adjusted.
+func (u *User) EnsureCanBeRated() error {
+	if u.status == enums.UserStatusDeleted {
+		return domainerrors.ErrUserDeleted
+	}
+
+	return nil
+}
+
+// EnsureCanPlay rejects new games of deleted and banned users. Suspended users can still report the games they
+// finished.
+func (u *User) EnsureCanPlay() error {
+	switch u.status {
+	case enums.UserStatusDeleted:
+		return domainerrors.ErrUserDeleted
+	case enums.UserStatusBanned:
+		return domainerrors.ErrUserBanned
+	default:
+		return nil
+	}
+}
+
+// Suspend blocks the user until the given time. Suspending a suspended user replaces the end of the suspension,
+// banned and deleted users cannot be suspended.
+func (u *User) Suspend(reason string, until time.Time) error {
+	if u.status != enums.UserStatusActive && u.status != enums.UserStatusSuspended {
+		return domainerrors.ErrInvalidStatusChange
+	}
+
+	if strings.TrimSpace(reason) == "" {
+		return domainerrors.ErrReasonRequired
+	}
+
+	if !until.After(time.Now()) {
+		return domainerrors.ErrInvalidSuspension
+	}
+
+	u.status = enums.UserStatusSuspended
+	u.suspendedUntil = &until
+
+	return nil
+}
+
+// Ban blocks the user until they are reinstated.
+func (u *User) Ban(reason string) error {
+	if u.status != enums.UserStatusActive && u.status != enums.UserStatusSuspended {
+		return domainerrors.ErrInvalidStatusChange
+	}
+
+	if strings.TrimSpace(reason) == "" {
+		return domainerrors.ErrReasonRequired
+	}
+
+	u.status = enums.UserStatusBanned
+	u.suspendedUntil = nil
+
+	return nil
+}
+
+// Reinstate lifts a suspension or a ban.
+func (u *User) Reinstate() error {
+	if u.status != enums.UserStatusSuspended && u.status != enums.UserStatusBanned {
+		return domainerrors.ErrInvalidStatusChange
+	}
+
+	u.status = enums.UserStatusActive
+	u.suspendedUntil = nil
+
+	return nil
+}
+
+// SuspensionExpired reports whether the user is suspended and the suspension ended before now.
+func (u *User) SuspensionExpired(now time.Time) bool {
+	return u.status == enums.UserStatusSuspended && u.suspendedUntil != nil && !u.suspendedUntil.After(now)
+}
+
+// ChangeTag replaces the tag of an active user. Checking that no one else holds the new tag is up to the caller.
+func (u *User) ChangeTag(newTag *tag.Tag) error {
+	if u.status != enums.UserStatusActive {
+		return domainerrors.ErrInvalidStatusChange
+	}
+
+	if u.tag != nil && u.tag.Value() == newTag.Value() {
+		return domainerrors.ErrTagUnchanged
+	}
+
+	u.tag = newTag
+
+	return nil
+}
+
+// ChangeEmail replaces the email of an active user. The new address is unverified until it is confirmed, checking
+// that no one else holds it is up to the caller.
+func (u *User) ChangeEmail(newEmail *email.Email) error {
+	if u.status != enums.UserStatusActive {
+		return domainerrors.ErrInvalidStatusChange
+	}
+
+	u.email = newEmail
+	u.isVerified = false
+	u.emailVerifiedAt = nil
+
+	return nil
+}
+
+// ChangeLanguage sets the language the user is written to in. Deleted users keep theirs.
+func (u *User) ChangeLanguage(language string) error {
+	if u.status == enums.UserStatusDeleted {
+		return domainerrors.ErrInvalidStatusChange
+	}
+
+	u.language = language
+
+	return nil
+}
+
+func (u *User) ChangePassword(hashed *password.HashedPassword) {
+	u.password = hashed
+}
+
+func (u *User) RegisterLogin() {
+	now := time.Now()
+
+	u.lastLoginAt = &now
+	u.lastActiveAt = now
+}
+
+func (u *User) VerifyEmail() error {
+	if u.isVerified {
+		return domainerrors.ErrEmailAlreadyVerified
+	}
+
+	now := time.Now()
+
+	u.isVerified = true
+	u.emailVerifiedAt = &now
+
+	return nil
+}
+
+// Delete closes the account. It can be restored until the grace period ends, then it is anonymized. Only active
+// users may delete their account, so deleting and restoring cannot be used to get around a suspension or a ban.
+func (u *User) Delete() error {
+	if u.status != enums.UserStatusActive {
+		return domainerrors.ErrInvalidStatusChange
+	}
+
+	now := time.Now()
+
+	u.status = enums.UserStatusDeleted
+	u.deletedAt = &now
+
+	return nil
+}
+
+// Restore reopens an account deleted less than gracePeriod ago.
+func (u *User) Restore(gracePeriod time.Duration) error {
+	if u.status != enums.UserStatusDeleted {
+		return domainerrors.ErrInvalidStatusChange
+	}
+
+	if u.anonymizedAt != nil || u.DeletionExpired(time.Now(), gracePeriod) {
+		return domainerrors.ErrRestoreUnavailable
+	}
+
+	u.status = enums.UserStatusActive
+	u.deletedAt = nil
+
+	return nil
+}
+
+// DeletionExpired reports whether the account was deleted and its grace period ended before now.
+func (u *User) DeletionExpired(now time.Time, gracePeriod time.Duration) bool {
+	return u.status == enums.UserStatusDeleted && u.deletedAt != nil && !u.deletedAt.Add(gracePeriod).After(now)
+}
+
+// Anonymize irreversibly replaces the email, tag and password of a deleted account. The replacements are derived
+// from the id, so they stay unique, and the tag starts with an underscore, which generated tags never do. The
+// "_d" prefix keeps the tag long enough for the smallest ids.
+func (u *User) Anonymize() error {
+	if u.status != enums.UserStatusDeleted || u.anonymizedAt != nil {
+		return domainerrors.ErrInvalidStatusChange
+	}
+
+	id := strconv.FormatInt(u.id, 36)
+
+	anonymousTag, err := tag.New("_d" + id)
+	if err != nil {
+		return err
+	}
+
+	anonymousEmail, err := email.New("deleted-" + id + "@deleted.invalid")
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	u.tag = anonymousTag
+	u.email = anonymousEmail
+	u.password = password.NewHashedPassword("")
+	u.isVerified = false
+	u.emailVerifiedAt = nil
+	u.lastLoginAt = nil
+	u.anonymizedAt = &now
+
+	return nil
+}
+
+// GrantPremium gives the user premium for the given period, added to the end of a running premium period.
+// Premium without an end is left as it is.
+func (u *User) GrantPremium(period time.Duration) error {
+	if u.status == enums.UserStatusDeleted {
+		return domainerrors.ErrInvalidStatusChange
+	}
+
+	if period <= 0 {
+		return domainerrors.ErrInvalidPremiumPeriod
+	}
+
+	if u.isPremium && u.premiumUntil == nil {
+		return nil
+	}
+
+	now := time.Now()
+
+	start := now
+	if u.IsPremiumAt(now) {
+		start = *u.premiumUntil
+	}
+
+	until := start.Add(period)
+
+	u.isPremium = true
+	u.premiumUntil = &until
+
+	return nil
+}
+
+func (u *User) RevokePremium() error {
+	if !u.isPremium {
+		return domainerrors.ErrNotPremium
+	}
+
+	u.isPremium = false
+	u.premiumUntil = nil
+
+	return nil
+}
+
+// IsPremiumAt reports whether the current premium period covers the instant. Premium without an end never expires.
+// The start of the period is not kept, so the answer only holds for now and later instants.
+func (u *User) IsPremiumAt(instant time.Time) bool {
+	return u.isPremium && (u.premiumUntil == nil || instant.Before(*u.premiumUntil))
+}
+
+// PremiumExpired reports whether the user is still flagged premium although the premium period ended before now.
+func (u *User) PremiumExpired(now time.Time) bool {
+	return u.isPremium && u.premiumUntil != nil && !u.premiumUntil.After(now)
+}
+
+// ExpirePremium turns premium off once its period is over. The end of the period is kept.
+func (u *User) ExpirePremium(now time.Time) error {
+	if !u.PremiumExpired(now) {
+		return domainerrors.ErrNotPremium
+	}
+
+	u.isPremium = false
+
+	return nil
+}
@@ -0,0 +1,363 @@
+package user
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/email"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/password"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/tag"
+)
+
+func TestUser_Moderation(t *testing.T) {
+	newUser := func(status enums.UserStatus) *User {
+		return NewBuilder().WithID(1).WithStatus(status).Build()
+	}
+
+	t.Run("should suspend an active user until the given time", func(t *testing.T) {
+		u := newUser(enums.UserStatusActive)
+		until := time.Now().Add(time.Hour)
+
+		require.NoError(t, u.Suspend("spam", until))
+
+		assert.Equal(t, enums.UserStatusSuspended, u.Status())
+		assert.Equal(t, until, *u.SuspendedUntil())
+		assert.ErrorIs(t, u.EnsureCanLogin(), domainerrors.ErrUserSuspended)
+	})
+
+	t.Run("should extend a suspension", func(t *testing.T) {
+		u := newUser(enums.UserStatusActive)
+		require.NoError(t, u.Suspend("spam", time.Now().Add(time.Hour)))
+
+		until := time.Now().Add(48 * time.Hour)
+		require.NoError(t, u.Suspend("spam again", until))
+
+		assert.Equal(t, until, *u.SuspendedUntil())
+	})
+
+	t.Run("should reject suspensions without a reason or ending in the past", func(t *testing.T) {
+		u := newUser(enums.UserStatusActive)
+
+		assert.ErrorIs(t, u.Suspend(" ", time.Now().Add(time.Hour)), domainerrors.ErrReasonRequired)
+		assert.ErrorIs(t, u.Suspend("spam", time.Now().Add(-time.Hour)), domainerrors.ErrInvalidSuspension)
+		assert.Equal(t, enums.UserStatusActive, u.Status())
+	})
+
+	t.Run("should not suspend or ban banned and deleted users", func(t *testing.T) {
+		for _, status := range []enums.UserStatus{enums.UserStatusBanned, enums.UserStatusDeleted} {
+			u := newUser(status)
+
+			assert.ErrorIs(t, u.Suspend("spam", time.Now().Add(time.Hour)), domainerrors.ErrInvalidStatusChange)
+			assert.ErrorIs(t, u.Ban("cheating"), domainerrors.ErrInvalidStatusChange)
+			assert.Equal(t, status, u.Status())
+		}
+	})
+
+	t.Run("should turn a suspension into a ban", func(t *testing.T) {
+		u := newUser(enums.UserStatusActive)
+		require.NoError(t, u.Suspend("spam", time.Now().Add(time.Hour)))
+
+		require.NoError(t, u.Ban("cheating"))
+
+		assert.Equal(t, enums.UserStatusBanned, u.Status())
+		assert.Nil(t, u.SuspendedUntil())
+		assert.ErrorIs(t, u.EnsureCanLogin(), domainerrors.ErrUserBanned)
+	})
+
+	t.Run("should reinstate suspended and banned users only", func(t *testing.T) {
+		for _, status := range []enums.UserStatus{enums.UserStatusSuspended, enums.UserStatusBanned} {
+			u := newUser(status)
+
+			require.NoError(t, u.Reinstate())
+			assert.Equal(t, enums.UserStatusActive, u.Status())
+			assert.Nil(t, u.SuspendedUntil())
+		}
+
+		assert.ErrorIs(t, newUser(enums.UserStatusActive).Reinstate(), domainerrors.ErrInvalidStatusChange)
+		assert.ErrorIs(t, newUser(enums.UserStatusDeleted).Reinstate(), domainerrors.ErrInvalidStatusChange)
+	})
+
+	t.Run("should rank and rate users by status", func(t *testing.T) {
+		for status, ranked := range map[enums.UserStatus]bool{
+			enums.UserStatusActive:    true,
+			enums.UserStatusSuspended: true,
+			enums.UserStatusBanned:    false,
+			enums.UserStatusDeleted:   false,
+		} {
+			assert.Equal(t, ranked, newUser(status).IsRanked(), status)
+		}
+
+		assert.NoError(t, newUser(enums.UserStatusBanned).EnsureCanBeRated())
+		assert.ErrorIs(t, newUser(enums.UserStatusDeleted).EnsureCanBeRated(), domainerrors.ErrUserDeleted)
+	})
+
+	t.Run("should only let active and suspended users play", func(t *testing.T) {
+		assert.NoError(t, newUser(enums.UserStatusActive).EnsureCanPlay())
+		assert.NoError(t, newUser(enums.UserStatusSuspended).EnsureCanPlay())
+		assert.ErrorIs(t, newUser(enums.UserStatusBanned).EnsureCanPlay(), domainerrors.ErrUserBanned)
+		assert.ErrorIs(t, newUser(enums.UserStatusDeleted).EnsureCanPlay(), domainerrors.ErrUserDeleted)
+	})
+
+	t.Run("should let users with an expired suspension sign in", func(t *testing.T) {
+		until := time.Now().Add(-time.Minute)
+		u := NewBuilder().WithStatus(enums.UserStatusSuspended).WithSuspendedUntil(&until).Build()
+
+		assert.True(t, u.SuspensionExpired(time.Now()))
+		assert.NoError(t, u.EnsureCanLogin())
+	})
+}
+
+func TestUser_Deletion(t *testing.T) {
+	newUser := func(status enums.UserStatus) *User {
+		emailVO, err := email.New("player@example.com")
+		require.NoError(t, err)
+
+		tagVO, err := tag.New("player01")
+		require.NoError(t, err)
+
+		return NewBuilder().
+			WithID(12345).
+			WithTag(tagVO).
+			WithEmail(emailVO).
+			WithPassword(password.NewHashedPassword("hash")).
+			WithStatus(status).
+			WithIsVerified(true).
+			Build()
+	}
+
+	t.Run("should delete active users only", func(t *testing.T) {
+		u := newUser(enums.UserStatusActive)
+
+		require.NoError(t, u.Delete())
+		assert.Equal(t, enums.UserStatusDeleted, u.Status())
+		assert.NotNil(t, u.DeletedAt())
+
+		for _, status := range []enums.UserStatus{enums.UserStatusSuspended, enums.UserStatusBanned, enums.UserStatusDeleted} {
+			assert.ErrorIs(t, newUser(status).Delete(), domainerrors.ErrInvalidStatusChange)
+		}
+	})
+
+	t.Run("should restore within the grace period", func(t *testing.T) {
+		u := newUser(enums.UserStatusActive)
+		require.NoError(t, u.Delete())
+
+		require.NoError(t, u.Restore(time.Hour))
+
+		assert.Equal(t, enums.UserStatusActive, u.Status())
+		assert.Nil(t, u.DeletedAt())
+	})
+
+	t.Run("should not restore after the grace period", func(t *testing.T) {
+		deletedAt := time.Now().Add(-2 * time.Hour)
+		u := NewBuilder().WithStatus(enums.UserStatusDeleted).WithDeletedAt(&deletedAt).Build()
+
+		assert.True(t, u.DeletionExpired(time.Now(), time.Hour))
+		assert.ErrorIs(t, u.Restore(time.Hour), domainerrors.ErrRestoreUnavailable)
+	})
+
+	t.Run("should replace personal data with values derived from the id", func(t *testing.T) {
+		u := newUser(enums.UserStatusActive)
+		require.NoError(t, u.Delete())
+
+		require.NoError(t, u.Anonymize())
+
+		assert.Equal(t, "_d9ix", u.Tag().Value())
+		assert.Equal(t, "deleted-9ix@deleted.invalid", u.Email().Value())
+		assert.Empty(t, u.Password().Value())
+		assert.False(t, u.IsVerified())
+		assert.NotNil(t, u.AnonymizedAt())
+		assert.ErrorIs(t, u.Restore(time.Hour), domainerrors.ErrRestoreUnavailable)
+		assert.ErrorIs(t, u.Anonymize(), domainerrors.ErrInvalidStatusChange)
+	})
+
+	t.Run("should build a valid tag for the smallest ids", func(t *testing.T) {
+		u := NewBuilder().WithID(1).WithStatus(enums.UserStatusDeleted).Build()
+
+		require.NoError(t, u.Anonymize())
+
+		assert.Equal(t, "_d1", u.Tag().Value())
+	})
+
+	t.Run("should not anonymize accounts that are not deleted", func(t *testing.T) {
+		assert.ErrorIs(t, newUser(enums.UserStatusActive).Anonymize(), domainerrors.ErrInvalidStatusChange)
+	})
+}
+
+func TestUser_Premium(t *testing.T) {
+	newUser := func() *User {
+		return NewBuilder().WithID(1).WithStatus(enums.UserStatusActive).Build()
+	}
+
+	t.Run("should grant premium from now", func(t *testing.T) {
+		u := newUser()
+		before := time.Now()
+
+		require.NoError(t, u.GrantPremium(24*time.Hour))
+
+		assert.True(t, u.IsPremium())
+		assert.WithinDuration(t, before.Add(24*time.Hour), *u.PremiumUntil(), time.Second)
+		assert.True(t, u.IsPremiumAt(time.Now()))
+		assert.False(t, u.IsPremiumAt(before.Add(25*time.Hour)))
+	})
+
+	t.Run("should extend a running premium period from its end", func(t *testing.T) {
+		u := newUser()
+		require.NoError(t, u.GrantPremium(24*time.Hour))
+		end := *u.PremiumUntil()
+
+		require.NoError(t, u.GrantPremium(time.Hour))
+
+		assert.Equal(t, end.Add(time.Hour), *u.PremiumUntil())
+	})
+
+	t.Run("should restart an expired premium period from now", func(t *testing.T) {
+		until := time.Now().Add(-time.Hour)
+		u := NewBuilder().WithStatus(enums.UserStatusActive).WithIsPremium(true).WithPremiumUntil(&until).Build()
+
+		require.NoError(t, u.GrantPremium(time.Hour))
+
+		assert.WithinDuration(t, time.Now().Add(time.Hour), *u.PremiumUntil(), time.Second)
+	})
+
+	t.Run("should reject invalid grants", func(t *testing.T) {
+		assert.ErrorIs(t, newUser().GrantPremium(0), domainerrors.ErrInvalidPremiumPeriod)
+
+		deleted := NewBuilder().WithStatus(enums.UserStatusDeleted).Build()
+		assert.ErrorIs(t, deleted.GrantPremium(time.Hour), domainerrors.ErrInvalidStatusChange)
+	})
+
+	t.Run("should revoke premium", func(t *testing.T) {
+		u := newUser()
+		require.NoError(t, u.GrantPremium(time.Hour))
+
+		require.NoError(t, u.RevokePremium())
+
+		assert.False(t, u.IsPremium())
+		assert.Nil(t, u.PremiumUntil())
+		assert.ErrorIs(t, u.RevokePremium(), domainerrors.ErrNotPremium)
+	})
+
+	t.Run("should expire premium only after its end", func(t *testing.T) {
+		u := newUser()
+		require.NoError(t, u.GrantPremium(time.Hour))
+
+		assert.ErrorIs(t, u.ExpirePremium(time.Now()), domainerrors.ErrNotPremium)
+
+		later := time.Now().Add(2 * time.Hour)
+		assert.True(t, u.PremiumExpired(later))
+		require.NoError(t, u.ExpirePremium(later))
+
+		assert.False(t, u.IsPremium())
+		assert.NotNil(t, u.PremiumUntil())
+	})
+
+	t.Run("should treat premium without an end as permanent", func(t *testing.T) {
+		u := NewBuilder().WithIsPremium(true).Build()
+
+		assert.True(t, u.IsPremiumAt(time.Now().Add(100*365*24*time.Hour)))
+		assert.False(t, u.PremiumExpired(time.Now()))
+
+		require.NoError(t, u.GrantPremium(time.Hour))
+		assert.Nil(t, u.PremiumUntil())
+	})
+}
+
+func TestUser_ChangeTag(t *testing.T) {
+	newUser := func(status enums.UserStatus) *User {
+		oldTag, _ := tag.New("old_tag")
+
+		return NewBuilder().WithID(1).WithTag(oldTag).WithStatus(status).Build()
+	}
+
+	t.Run("should replace the tag", func(t *testing.T) {
+		u := newUser(enums.UserStatusActive)
+		newTag, _ := tag.New("new_tag")
+
+		require.NoError(t, u.ChangeTag(newTag))
+
+		assert.Equal(t, "new_tag", u.Tag().Value())
+	})
+
+	t.Run("should reject the current tag", func(t *testing.T) {
+		u := newUser(enums.UserStatusActive)
+		sameTag, _ := tag.New("old_tag")
+
+		assert.ErrorIs(t, u.ChangeTag(sameTag), domainerrors.ErrTagUnchanged)
+	})
+
+	t.Run("should only change the tag of active users", func(t *testing.T) {
+		newTag, _ := tag.New("new_tag")
+
+		for _, status := range []enums.UserStatus{
+			enums.UserStatusSuspended,
+			enums.UserStatusBanned,
+			enums.UserStatusDeleted,
+		} {
+			u := newUser(status)
+
+			assert.ErrorIs(t, u.ChangeTag(newTag), domainerrors.ErrInvalidStatusChange)
+			assert.Equal(t, "old_tag", u.Tag().Value())
+		}
+	})
+}
+
+func TestUser_ChangeEmail(t *testing.T) {
+	newUser := func(status enums.UserStatus) *User {
+		oldEmail, _ := email.New("old@example.com")
+		verifiedAt := time.Now()
+
+		return NewBuilder().
+			WithID(1).
+			WithEmail(oldEmail).
+			WithStatus(status).
+			WithIsVerified(true).
+			WithEmailVerifiedAt(&verifiedAt).
+			Build()
+	}
+
+	t.Run("should replace the email and mark it unverified", func(t *testing.T) {
+		u := newUser(enums.UserStatusActive)
+		newEmail, _ := email.New("new@example.com")
+
+		require.NoError(t, u.ChangeEmail(newEmail))
+
+		assert.Equal(t, "new@example.com", u.Email().Value())
+		assert.False(t, u.IsVerified())
+		assert.Nil(t, u.EmailVerifiedAt())
+	})
+
+	t.Run("should only change the email of active users", func(t *testing.T) {
+		newEmail, _ := email.New("new@example.com")
+
+		for _, status := range []enums.UserStatus{
+			enums.UserStatusSuspended,
+			enums.UserStatusBanned,
+			enums.UserStatusDeleted,
+		} {
+			u := newUser(status)
+
+			assert.ErrorIs(t, u.ChangeEmail(newEmail), domainerrors.ErrInvalidStatusChange)
+			assert.Equal(t, "old@example.com", u.Email().Value())
+			assert.True(t, u.IsVerified())
+		}
+	})
+}
+
+func TestUser_ChangeLanguage(t *testing.T) {
+	t.Run("should change the language of every user but deleted ones", func(t *testing.T) {
+		u := NewBuilder().WithID(1).WithStatus(enums.UserStatusDeleted).WithLanguage("en").Build()
+
+		assert.ErrorIs(t, u.ChangeLanguage("de"), domainerrors.ErrInvalidStatusChange)
+		assert.Equal(t, "en", u.Language())
+
+		u = NewBuilder().WithID(1).WithStatus(enums.UserStatusBanned).WithLanguage("en").Build()
+
+		require.NoError(t, u.ChangeLanguage("de"))
+		assert.Equal(t, "de", u.Language())
+	})
+}
@@ -9,6 +9,14 @@ const (
 	UserStatusDeleted   UserStatus = "deleted"
 )
 
+type ModerationAction string
+
+const (
+	ModerationActionSuspend   ModerationAction = "suspend"
+	ModerationActionBan       ModerationAction = "ban"
+	ModerationActionReinstate ModerationAction = "reinstate"
+)
+
 type TimeControl string
 
 const (
@@ -19,6 +27,31 @@ const (
 	TimeControlCorrespondence TimeControl = "correspondence"
 )
 
+var TimeControls = []TimeControl{
+	TimeControlBullet,
+	TimeControlBlitz,
+	TimeControlRapid,
+	TimeControlClassical,
+	TimeControlCorrespondence,
+}
+
+func (tc TimeControl) IsValid() bool {
+	switch tc {
+	case TimeControlBullet, TimeControlBlitz, TimeControlRapid, TimeControlClassical, TimeControlCorrespondence:
+		return true
+	default:
+		return false
+	}
+}
+
+type GameOutcome string
+
+const (
+	GameOutcomeWhiteWins GameOutcome = "white_wins"
+	GameOutcomeBlackWins GameOutcome = "black_wins"
+	GameOutcomeDraw      GameOutcome = "draw"
+)
+
 type ChangeReason string
 
 const (
@@ -26,4 +59,24 @@ const (
 	ChangeReasonAdjustment  ChangeReason = "adjustment"
 	ChangeReasonSeasonReset ChangeReason = "season_reset"
 	ChangeReasonPenalty     ChangeReason = "penalty"
+	// ChangeReasonRefund gives back rating lost in a game against a player found to be cheating.
+	ChangeReasonRefund ChangeReason = "refund"
 )
+
+// ChartInterval is the width of a rating chart bucket. Values are valid date_trunc fields.
+type ChartInterval string
+
+const (
+	ChartIntervalDay   ChartInterval = "day"
+	ChartIntervalWeek  ChartInterval = "week"
+	ChartIntervalMonth ChartInterval = "month"
+)
+
+func (ci ChartInterval) IsValid() bool {
+	switch ci {
+	case ChartIntervalDay, ChartIntervalWeek, ChartIntervalMonth:
+		return true
+	default:
+		return false
+	}
+}
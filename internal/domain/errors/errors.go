@@ -3,16 +3,34 @@ package errors
 import "errors"
 
 var (
-	ErrUserNotFound        = errors.New("user not found")
-	ErrUserAlreadyExists   = errors.New("user already exists")
-	ErrInvalidCredentials  = errors.New("invalid credentials")
-	ErrUserNotVerified     = errors.New("user not verified")
-	ErrUserSuspended       = errors.New("user suspended")
-	ErrUserBanned          = errors.New("user banned")
-	ErrRatingNotFound      = errors.New("rating not found")
-	ErrInvalidRatingChange = errors.New("invalid rating change")
-	ErrUsernameUnavailable = errors.New("username unavailable")
-	ErrEmailUnavailable    = errors.New("email unavailable")
+	ErrUserNotFound         = errors.New("user not found")
+	ErrUserAlreadyExists    = errors.New("user already exists")
+	ErrInvalidCredentials   = errors.New("invalid credentials")
+	ErrUserNotVerified      = errors.New("user not verified")
+	ErrUserSuspended        = errors.New("user suspended")
+	ErrUserBanned           = errors.New("user banned")
+	ErrUserDeleted          = errors.New("user deleted")
+	ErrRatingNotFound       = errors.New("rating not found")
+	ErrInvalidRatingChange  = errors.New("invalid rating change")
+	ErrGameAlreadyRecorded  = errors.New("game already recorded")
+	ErrUsernameUnavailable  = errors.New("username unavailable")
+	ErrEmailUnavailable     = errors.New("email unavailable")
+	ErrEmailAlreadyVerified = errors.New("email already verified")
+	ErrInvalidToken         = errors.New("invalid or expired token")
+	ErrInvalidStatusChange  = errors.New("invalid status change")
+	ErrReasonRequired       = errors.New("reason required")
+	ErrInvalidSuspension    = errors.New("suspension must end in the future")
+	ErrRestoreUnavailable   = errors.New("account can no longer be restored")
+	ErrInvalidPremiumPeriod = errors.New("premium period must be positive")
+	ErrNotPremium           = errors.New("user is not premium")
+	ErrTagUnchanged         = errors.New("tag is unchanged")
+	ErrInvalidTag           = errors.New("invalid tag")
+	ErrNameReserved         = errors.New("name is reserved")
+	ErrNameProfane          = errors.New("name contains inappropriate language")
+	ErrSeasonNotFound       = errors.New("season not found")
+	ErrSeasonEnded          = errors.New("season already ended")
+	ErrSeasonResetPending   = errors.New("season reset in progress")
 
-	ErrGeneratingSessionID = errors.New("generating session id failed")
+	ErrGeneratingSessionID       = errors.New("generating session id failed")
+	ErrGeneratingRatingHistoryID = errors.New("generating rating history id failed")
 )
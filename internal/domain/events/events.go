@@ -0,0 +1,155 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+)
+
+const (
+	TypeUserRegistered    = "user.registered"
+	TypeUserVerified      = "user.verified"
+	TypeUserUpdated       = "user.updated"
+	TypeUserStatusChanged = "user.status_changed"
+	TypeUserAnonymized    = "user.anonymized"
+	TypePremiumChanged    = "user.premium_changed"
+	TypeTagChanged        = "user.tag_changed"
+	TypeProfileUpdated    = "profile.updated"
+	TypeRatingChanged     = "rating.changed"
+)
+
+// Event is a fact about a user that other services may react to. Every event belongs to the user it is about,
+// events of one user are delivered in the order they were recorded.
+type Event interface {
+	Type() string
+	AggregateID() int64
+}
+
+// Outbox records events in the transaction of the write they describe, so they are published exactly when the
+// write commits.
+type Outbox interface {
+	Append(ctx context.Context, events ...Event) error
+}
+
+type UserRegistered struct {
+	UserID       int64     `json:"user_id"`
+	Tag          string    `json:"tag"`
+	Email        string    `json:"email"`
+	Language     string    `json:"language"`
+	RegisteredAt time.Time `json:"registered_at"`
+}
+
+func (e *UserRegistered) Type() string       { return TypeUserRegistered }
+func (e *UserRegistered) AggregateID() int64 { return e.UserID }
+
+type UserVerified struct {
+	UserID     int64     `json:"user_id"`
+	Email      string    `json:"email"`
+	VerifiedAt time.Time `json:"verified_at"`
+}
+
+func (e *UserVerified) Type() string       { return TypeUserVerified }
+func (e *UserVerified) AggregateID() int64 { return e.UserID }
+
+type UserUpdated struct {
+	UserID   int64  `json:"user_id"`
+	Email    string `json:"email"`
+	Language string `json:"language"`
+	// ChangedFields lists the user fields touched by the update, using their API names.
+	ChangedFields []string  `json:"changed_fields"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+func (e *UserUpdated) Type() string       { return TypeUserUpdated }
+func (e *UserUpdated) AggregateID() int64 { return e.UserID }
+
+type UserStatusChanged struct {
+	UserID    int64            `json:"user_id"`
+	OldStatus enums.UserStatus `json:"old_status"`
+	NewStatus enums.UserStatus `json:"new_status"`
+	Reason    string           `json:"reason,omitempty"`
+	ChangedAt time.Time        `json:"changed_at"`
+}
+
+func (e *UserStatusChanged) Type() string       { return TypeUserStatusChanged }
+func (e *UserStatusChanged) AggregateID() int64 { return e.UserID }
+
+// UserAnonymized tells consumers to erase whatever personal data of the user they keep.
+type UserAnonymized struct {
+	UserID       int64     `json:"user_id"`
+	AnonymizedAt time.Time `json:"anonymized_at"`
+}
+
+func (e *UserAnonymized) Type() string       { return TypeUserAnonymized }
+func (e *UserAnonymized) AggregateID() int64 { return e.UserID }
+
+const (
+	PremiumGranted  = "granted"
+	PremiumExtended = "extended"
+	PremiumRevoked  = "revoked"
+	PremiumExpired  = "expired"
+)
+
+type PremiumChanged struct {
+	UserID int64 `json:"user_id"`
+	// Change is one of PremiumGranted, PremiumExtended, PremiumRevoked or PremiumExpired.
+	Change       string     `json:"change"`
+	IsPremium    bool       `json:"is_premium"`
+	PremiumUntil *time.Time `json:"premium_until,omitempty"`
+	ChangedAt    time.Time  `json:"changed_at"`
+}
+
+func (e *PremiumChanged) Type() string       { return TypePremiumChanged }
+func (e *PremiumChanged) AggregateID() int64 { return e.UserID }
+
+// TagChanged is recorded when a user picks a new tag. OldTag keeps resolving to the user for a while.
+type TagChanged struct {
+	UserID    int64     `json:"user_id"`
+	OldTag    string    `json:"old_tag"`
+	NewTag    string    `json:"new_tag"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+func (e *TagChanged) Type() string       { return TypeTagChanged }
+func (e *TagChanged) AggregateID() int64 { return e.UserID }
+
+type ProfileUpdated struct {
+	UserID     int64  `json:"user_id"`
+	PublicName string `json:"public_name"`
+	// ChangedFields lists the profile fields touched by the update, using their API names.
+	ChangedFields []string  `json:"changed_fields"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+func (e *ProfileUpdated) Type() string       { return TypeProfileUpdated }
+func (e *ProfileUpdated) AggregateID() int64 { return e.UserID }
+
+type RatingChanged struct {
+	UserID       int64              `json:"user_id"`
+	TimeControl  enums.TimeControl  `json:"time_control"`
+	OldRating    int                `json:"old_rating"`
+	NewRating    int                `json:"new_rating"`
+	ChangeReason enums.ChangeReason `json:"change_reason"`
+	GameID       *uuid.UUID         `json:"game_id,omitempty"`
+	ChangedAt    time.Time          `json:"changed_at"`
+}
+
+func (e *RatingChanged) Type() string       { return TypeRatingChanged }
+func (e *RatingChanged) AggregateID() int64 { return e.UserID }
+
+// NewRatingChanged describes the change recorded by a rating history entry.
+func NewRatingChanged(history *user.RatingHistory) *RatingChanged {
+	return &RatingChanged{
+		UserID:       history.UserID,
+		TimeControl:  history.TimeControl,
+		OldRating:    history.OldRating,
+		NewRating:    history.NewRating,
+		ChangeReason: history.ChangeReason,
+		GameID:       history.GameID,
+		ChangedAt:    history.CreatedAt,
+	}
+}
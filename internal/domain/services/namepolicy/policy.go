@@ -0,0 +1,180 @@
+package namepolicy
+
+import (
+	_ "embed"
+	"strings"
+
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+)
+
+// minReservedPrefixLen keeps short reserved words such as titles from rejecting every name that starts with them.
+const minReservedPrefixLen = 4
+
+//go:embed profanity.txt
+var defaultProfanity string
+
+//go:embed allowed.txt
+var defaultAllowed string
+
+// DefaultReserved is used when no reserved words are configured.
+var DefaultReserved = []string{
+	"admin", "administrator", "moderator", "mod", "support", "staff", "system", "official", "chesshub", "deleted",
+	"gm", "im", "fm", "cm", "nm", "wgm", "wim", "wfm", "wcm",
+}
+
+// confusables folds characters that read alike onto one, so look-alike spellings of a word compare equal.
+var confusables = strings.NewReplacer(
+	"0", "o",
+	"1", "l",
+	"i", "l",
+	"3", "e",
+	"4", "a",
+	"5", "s",
+	"7", "t",
+	"8", "b",
+	"9", "g",
+)
+
+// Policy decides which public names and tags users may pick. Names are split into words on '.', '_' and '-' and
+// compared after normalisation. A name is reserved when one of its words or the whole name is a reserved word, or
+// when it starts with a reserved word of at least minReservedPrefixLen letters. A name is profane when it contains
+// a word of the profanity list anywhere that is not part of an allowed word, so "Scunthorpe" passes while the
+// profane word on its own does not. Names starting with '_' are reserved for anonymized accounts.
+type Policy struct {
+	reserved  map[string]struct{}
+	profanity []string
+	allowed   []string
+}
+
+// New builds a policy from the reserved words and the profanity and allowed words added to the built-in lists.
+func New(reserved, profanity, allowed []string) *Policy {
+	p := &Policy{
+		reserved: make(map[string]struct{}, len(reserved)),
+	}
+
+	for _, word := range reserved {
+		if word = Normalize(word); word != "" {
+			p.reserved[word] = struct{}{}
+		}
+	}
+
+	p.profanity = normalizeAll(append(profanity, readList(defaultProfanity)...))
+	p.allowed = normalizeAll(append(allowed, readList(defaultAllowed)...))
+
+	return p
+}
+
+// Check returns ErrNameReserved or ErrNameProfane when the name may not be used.
+func (p *Policy) Check(name string) error {
+	if strings.HasPrefix(name, "_") {
+		return domainerrors.ErrNameReserved
+	}
+
+	words := strings.FieldsFunc(name, isSeparator)
+	whole := Normalize(name)
+
+	if p.isReserved(whole) {
+		return domainerrors.ErrNameReserved
+	}
+
+	for _, word := range words {
+		if _, ok := p.reserved[Normalize(word)]; ok {
+			return domainerrors.ErrNameReserved
+		}
+	}
+
+	if p.isProfane(whole) {
+		return domainerrors.ErrNameProfane
+	}
+
+	return nil
+}
+
+func (p *Policy) isProfane(name string) bool {
+	for _, word := range p.profanity {
+		for from := 0; ; {
+			i := strings.Index(name[from:], word)
+			if i < 0 {
+				break
+			}
+
+			start := from + i
+			if !p.isAllowedAt(name, start, start+len(word)) {
+				return true
+			}
+
+			from = start + 1
+		}
+	}
+
+	return false
+}
+
+// isAllowedAt reports whether an allowed word covers name[start:end].
+func (p *Policy) isAllowedAt(name string, start, end int) bool {
+	for _, word := range p.allowed {
+		for from := max(end-len(word), 0); from <= start; from++ {
+			if strings.HasPrefix(name[from:], word) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (p *Policy) isReserved(name string) bool {
+	if _, ok := p.reserved[name]; ok {
+		return true
+	}
+
+	for word := range p.reserved {
+		if len(word) >= minReservedPrefixLen && strings.HasPrefix(name, word) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Normalize lowercases the name, drops separators and folds confusable characters.
+func Normalize(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if isSeparator(r) {
+			return -1
+		}
+
+		return r
+	}, strings.ToLower(name))
+
+	return confusables.Replace(name)
+}
+
+// readList returns the words of an embedded list, skipping blank lines and comments.
+func readList(list string) []string {
+	var words []string
+
+	for _, line := range strings.Split(list, "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+			words = append(words, line)
+		}
+	}
+
+	return words
+}
+
+func normalizeAll(words []string) []string {
+	normalized := make([]string, 0, len(words))
+
+	for _, word := range words {
+		if word = Normalize(word); word != "" {
+			normalized = append(normalized, word)
+		}
+	}
+
+	return normalized
+}
+
+func isSeparator(r rune) bool {
+	return r == '.' || r == '_' || r == '-'
+}
@@ -0,0 +1,62 @@
+package namepolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Run("should lowercase, drop separators and fold confusables", func(t *testing.T) {
+		assert.Equal(t, "admln", Normalize("Adm1n"))
+		assert.Equal(t, "admln", Normalize("a.d-m_I_n"))
+		assert.Equal(t, "chesshub", Normalize("CH3SSHU8"))
+		assert.Equal(t, "moderator", Normalize("m0der4tor"))
+	})
+}
+
+func TestPolicy_Check(t *testing.T) {
+	policy := New(DefaultReserved, []string{"badword"}, []string{"badwordsworth"})
+
+	t.Run("should allow ordinary names", func(t *testing.T) {
+		for _, name := range []string{"magnus", "hikaru_n", "grapefruit", "parser", "tim.smith", "gmail_fan", "badminton"} {
+			assert.NoError(t, policy.Check(name), name)
+		}
+	})
+
+	t.Run("should reject reserved words and their look-alikes", func(t *testing.T) {
+		for _, name := range []string{"admin", "Adm1n", "ADMIN_2", "chesshub", "ch3sshub_team", "m0derator", "deleted_x1"} {
+			assert.ErrorIs(t, policy.Check(name), domainerrors.ErrNameReserved, name)
+		}
+	})
+
+	t.Run("should reject titles used as a word", func(t *testing.T) {
+		for _, name := range []string{"GM_Magnus", "magnus.gm", "im-bob", "WGM_anna"} {
+			assert.ErrorIs(t, policy.Check(name), domainerrors.ErrNameReserved, name)
+		}
+	})
+
+	t.Run("should reject names starting with an underscore", func(t *testing.T) {
+		assert.ErrorIs(t, policy.Check("_abc"), domainerrors.ErrNameReserved)
+	})
+
+	t.Run("should reject profanity anywhere in the name", func(t *testing.T) {
+		for _, name := range []string{"sh1thead", "xFUCKx", "b.i.t.c.h", "my_badword"} {
+			assert.ErrorIs(t, policy.Check(name), domainerrors.ErrNameProfane, name)
+		}
+	})
+
+	t.Run("should allow names that contain profanity inside an allowed word", func(t *testing.T) {
+		for _, name := range []string{"Nazir", "Nazia", "Nazli", "Yoshitaka", "Ashita", "Scunthorpe", "badwordsworth"} {
+			assert.NoError(t, policy.Check(name), name)
+		}
+	})
+
+	t.Run("should reject profanity next to an allowed word", func(t *testing.T) {
+		for _, name := range []string{"nazir_shit", "scunthorpe.cunt", "badword_badwordsworth"} {
+			assert.ErrorIs(t, policy.Check(name), domainerrors.ErrNameProfane, name)
+		}
+	})
+}
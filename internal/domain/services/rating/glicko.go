@@ -0,0 +1,240 @@
+package rating
+
+import (
+	"math"
+	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultTau constrains the change in volatility over time, see the Glicko-2 paper for reasonable values.
+	DefaultTau = 0.5
+
+	glickoScale          = 173.7178
+	glickoBaseRating     = 1500
+	maxDeviation         = 350
+	convergenceTolerance = 0.000001
+)
+
+type GameResult struct {
+	GameID   uuid.UUID
+	White    *user.Rating
+	Black    *user.Rating
+	Outcome  enums.GameOutcome
+	PlayedAt time.Time
+}
+
+type Engine struct {
+	tau       float64
+	placement *user.Placement
+}
+
+// NewEngine rates provisional players with the raised volatility of placement, a nil placement has no
+// provisional players.
+func NewEngine(tau float64, placement *user.Placement) *Engine {
+	return &Engine{
+		tau:       tau,
+		placement: placement,
+	}
+}
+
+// Apply updates both players' ratings with the game result, treating the game as its own rating period,
+// and returns the history entries for white and black.
+func (e *Engine) Apply(result *GameResult) (*user.RatingHistory, *user.RatingHistory, error) {
+	white, black := result.White, result.Black
+
+	if white.TimeControl != black.TimeControl || white.UserID == black.UserID {
+		return nil, nil, domainerrors.ErrInvalidRatingChange
+	}
+
+	whiteScore, blackScore, err := scores(result.Outcome)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	whitePlayer, blackPlayer := e.toPlayer(white), e.toPlayer(black)
+
+	newWhite := e.rate(white, []opponentResult{{blackPlayer, whiteScore}})
+	newBlack := e.rate(black, []opponentResult{{whitePlayer, blackScore}})
+
+	whiteHistory, err := applyPlayer(white, newWhite, whiteScore, result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blackHistory, err := applyPlayer(black, newBlack, blackScore, result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	e.placement.Mark(white, black)
+
+	return whiteHistory, blackHistory, nil
+}
+
+func scores(outcome enums.GameOutcome) (float64, float64, error) {
+	switch outcome {
+	case enums.GameOutcomeWhiteWins:
+		return 1, 0, nil
+	case enums.GameOutcomeBlackWins:
+		return 0, 1, nil
+	case enums.GameOutcomeDraw:
+		return 0.5, 0.5, nil
+	default:
+		return 0, 0, domainerrors.ErrInvalidRatingChange
+	}
+}
+
+func applyPlayer(r *user.Rating, p player, score float64, result *GameResult) (*user.RatingHistory, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, domainerrors.ErrGeneratingRatingHistoryID
+	}
+
+	oldRating := r.Rating
+	newRating := int(math.Round(p.rating))
+
+	r.ApplyGame(newRating, p.deviation, p.volatility, score, result.PlayedAt)
+
+	gameID := result.GameID
+
+	return &user.RatingHistory{
+		Id:           id,
+		UserID:       r.UserID,
+		TimeControl:  r.TimeControl,
+		OldRating:    oldRating,
+		NewRating:    newRating,
+		RatingRange:  int(math.Round(p.deviation)),
+		GameID:       &gameID,
+		ChangeReason: enums.ChangeReasonGame,
+		CreatedAt:    result.PlayedAt,
+	}, nil
+}
+
+type player struct {
+	rating     float64
+	deviation  float64
+	volatility float64
+}
+
+type opponentResult struct {
+	opponent player
+	score    float64
+}
+
+func (e *Engine) toPlayer(r *user.Rating) player {
+	return player{
+		rating:     float64(r.Rating),
+		deviation:  r.Deviation,
+		volatility: e.placement.Volatility(r),
+	}
+}
+
+// rate updates the rating with the placement volatility, which only speeds up the rating and its deviation:
+// the volatility that is stored keeps evolving from the player's own.
+func (e *Engine) rate(r *user.Rating, results []opponentResult) player {
+	p := e.toPlayer(r)
+	updated := e.update(p, results)
+
+	if p.volatility != r.Volatility {
+		p.volatility = r.Volatility
+		updated.volatility = e.update(p, results).volatility
+	}
+
+	return updated
+}
+
+// update implements steps 2-8 of "Example of the Glicko-2 system" by Mark Glickman.
+func (e *Engine) update(p player, results []opponentResult) player {
+	mu := (p.rating - glickoBaseRating) / glickoScale
+	phi := p.deviation / glickoScale
+
+	if len(results) == 0 {
+		return player{
+			rating:     p.rating,
+			deviation:  math.Min(math.Sqrt(phi*phi+p.volatility*p.volatility)*glickoScale, maxDeviation),
+			volatility: p.volatility,
+		}
+	}
+
+	var invV, deltaSum float64
+
+	for _, res := range results {
+		muJ := (res.opponent.rating - glickoBaseRating) / glickoScale
+		phiJ := res.opponent.deviation / glickoScale
+
+		gJ := g(phiJ)
+		expected := 1 / (1 + math.Exp(-gJ*(mu-muJ)))
+
+		invV += gJ * gJ * expected * (1 - expected)
+		deltaSum += gJ * (res.score - expected)
+	}
+
+	v := 1 / invV
+	delta := v * deltaSum
+
+	sigma := e.volatility(phi, p.volatility, v, delta)
+
+	phiStar := math.Sqrt(phi*phi + sigma*sigma)
+	newPhi := 1 / math.Sqrt(1/(phiStar*phiStar)+1/v)
+	newMu := mu + newPhi*newPhi*deltaSum
+
+	return player{
+		rating:     newMu*glickoScale + glickoBaseRating,
+		deviation:  math.Min(newPhi*glickoScale, maxDeviation),
+		volatility: sigma,
+	}
+}
+
+// volatility finds the new volatility with the Illinois algorithm (step 5 of the paper).
+func (e *Engine) volatility(phi, sigma, v, delta float64) float64 {
+	a := math.Log(sigma * sigma)
+	tau2 := e.tau * e.tau
+
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		d := phi*phi + v + ex
+
+		return ex*(delta*delta-phi*phi-v-ex)/(2*d*d) - (x-a)/tau2
+	}
+
+	upper := a
+
+	var lower float64
+
+	if delta*delta > phi*phi+v {
+		lower = math.Log(delta*delta - phi*phi - v)
+	} else {
+		k := 1.0
+		for f(a-k*e.tau) < 0 {
+			k++
+		}
+
+		lower = a - k*e.tau
+	}
+
+	fUpper, fLower := f(upper), f(lower)
+
+	for math.Abs(lower-upper) > convergenceTolerance {
+		c := upper + (upper-lower)*fUpper/(fLower-fUpper)
+		fC := f(c)
+
+		if fC*fLower <= 0 {
+			upper, fUpper = lower, fLower
+		} else {
+			fUpper /= 2
+		}
+
+		lower, fLower = c, fC
+	}
+
+	return math.Exp(upper / 2)
+}
+
+func g(phi float64) float64 {
+	return 1 / math.Sqrt(1+3*phi*phi/(math.Pi*math.Pi))
+}
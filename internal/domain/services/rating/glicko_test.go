@@ -0,0 +1,129 @@
+package rating
+
+import (
+	"testing"
+	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdate(t *testing.T) {
+	t.Run("should match the example from the Glicko-2 paper", func(t *testing.T) {
+		e := NewEngine(DefaultTau, nil)
+
+		p := e.update(player{rating: 1500, deviation: 200, volatility: 0.06}, []opponentResult{
+			{opponent: player{rating: 1400, deviation: 30}, score: 1},
+			{opponent: player{rating: 1550, deviation: 100}, score: 0},
+			{opponent: player{rating: 1700, deviation: 300}, score: 0},
+		})
+
+		assert.InDelta(t, 1464.06, p.rating, 0.01)
+		assert.InDelta(t, 151.52, p.deviation, 0.01)
+		assert.InDelta(t, 0.05999, p.volatility, 0.00001)
+	})
+}
+
+func TestApply(t *testing.T) {
+	newRating := func(userID int64, tc enums.TimeControl) *user.Rating {
+		r := &user.Rating{}
+		require.NoError(t, r.Initialize(userID, tc))
+
+		return r
+	}
+
+	t.Run("should update both ratings and emit game history", func(t *testing.T) {
+		white := newRating(1, enums.TimeControlBlitz)
+		black := newRating(2, enums.TimeControlBlitz)
+		gameID := uuid.New()
+		playedAt := time.Now()
+
+		whiteHistory, blackHistory, err := NewEngine(DefaultTau, nil).Apply(&GameResult{
+			GameID:   gameID,
+			White:    white,
+			Black:    black,
+			Outcome:  enums.GameOutcomeWhiteWins,
+			PlayedAt: playedAt,
+		})
+		require.NoError(t, err)
+
+		assert.Greater(t, white.Rating, 1200)
+		assert.Less(t, black.Rating, 1200)
+		assert.Equal(t, white.Rating, white.PeakRating)
+		assert.Equal(t, black.Rating, black.LowestRating)
+		assert.Less(t, white.Deviation, 350.0)
+		assert.Equal(t, 1, white.Wins)
+		assert.Equal(t, 1, black.Losses)
+		assert.Equal(t, 1, white.GamesPlayed)
+		assert.Equal(t, &playedAt, white.LastGameAt)
+
+		assert.Equal(t, 1200, whiteHistory.OldRating)
+		assert.Equal(t, white.Rating, whiteHistory.NewRating)
+		assert.Equal(t, enums.ChangeReasonGame, blackHistory.ChangeReason)
+		assert.Equal(t, gameID, *blackHistory.GameID)
+		assert.Equal(t, white.Rating-1200, 1200-black.Rating)
+	})
+
+	t.Run("should count draws for both players", func(t *testing.T) {
+		white := newRating(1, enums.TimeControlRapid)
+		black := newRating(2, enums.TimeControlRapid)
+
+		_, _, err := NewEngine(DefaultTau, nil).Apply(&GameResult{
+			GameID:   uuid.New(),
+			White:    white,
+			Black:    black,
+			Outcome:  enums.GameOutcomeDraw,
+			PlayedAt: time.Now(),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, 1200, white.Rating)
+		assert.Equal(t, 1, white.Draws)
+		assert.Equal(t, 1, black.Draws)
+	})
+
+	t.Run("should rate provisional players with placement volatility", func(t *testing.T) {
+		placement := user.NewPlacement(map[enums.TimeControl]int{enums.TimeControlBlitz: 2}, 0.09)
+
+		play := func(engine *Engine) (*user.Rating, *user.Rating) {
+			white := newRating(1, enums.TimeControlBlitz)
+			black := newRating(2, enums.TimeControlBlitz)
+			white.Deviation = 60
+			black.GamesPlayed = 2
+
+			_, _, err := engine.Apply(&GameResult{
+				GameID:   uuid.New(),
+				White:    white,
+				Black:    black,
+				Outcome:  enums.GameOutcomeWhiteWins,
+				PlayedAt: time.Now(),
+			})
+			require.NoError(t, err)
+
+			return white, black
+		}
+
+		white, black := play(NewEngine(DefaultTau, placement))
+		established, _ := play(NewEngine(DefaultTau, nil))
+
+		assert.Greater(t, white.Deviation, established.Deviation)
+		assert.InDelta(t, established.Volatility, white.Volatility, 1e-9)
+		assert.Less(t, white.Volatility, 0.09)
+		assert.True(t, white.Provisional)
+		assert.False(t, black.Provisional)
+	})
+
+	t.Run("should return error when time controls differ", func(t *testing.T) {
+		_, _, err := NewEngine(DefaultTau, nil).Apply(&GameResult{
+			GameID:   uuid.New(),
+			White:    newRating(1, enums.TimeControlBlitz),
+			Black:    newRating(2, enums.TimeControlBullet),
+			Outcome:  enums.GameOutcomeDraw,
+			PlayedAt: time.Now(),
+		})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,41 @@
+package rating
+
+import (
+	"math"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+)
+
+// SoftReset compresses ratings toward the mean at the end of a season. Gaps between players shrink but their
+// order is kept, and the raised deviation lets the first games of the new season move ratings quickly.
+type SoftReset struct {
+	// compression is the share of the distance to the mean that is kept: 0 puts everyone on the mean, 1 keeps
+	// ratings as they are.
+	compression float64
+	// deviation is the deviation ratings are reset to. Ratings that are less certain already keep theirs.
+	deviation float64
+}
+
+func NewSoftReset(compression, deviation float64) (*SoftReset, error) {
+	if compression < 0 || compression > 1 || deviation <= 0 || deviation > maxDeviation {
+		return nil, domainerrors.ErrInvalidRatingChange
+	}
+
+	return &SoftReset{
+		compression: compression,
+		deviation:   deviation,
+	}, nil
+}
+
+// Rating returns the value old is reset to, within the bounds of ratings set outside of games.
+func (s *SoftReset) Rating(old int, mean float64) int {
+	value := int(math.Round(mean + (float64(old)-mean)*s.compression))
+
+	return min(max(value, user.MinRating), user.MaxRating)
+}
+
+// Apply resets the rating toward mean.
+func (s *SoftReset) Apply(r *user.Rating, mean float64) error {
+	return r.ResetForSeason(s.Rating(r.Rating, mean), max(r.Deviation, s.deviation))
+}
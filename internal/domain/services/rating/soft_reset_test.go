@@ -0,0 +1,55 @@
+package rating
+
+import (
+	"testing"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSoftReset(t *testing.T) {
+	t.Run("should compress ratings toward the mean", func(t *testing.T) {
+		s, err := NewSoftReset(0.5, 200)
+		require.NoError(t, err)
+
+		assert.Equal(t, 1750, s.Rating(2000, 1500))
+		assert.Equal(t, 1300, s.Rating(1100, 1500))
+		assert.Equal(t, 1500, s.Rating(1500, 1500))
+	})
+
+	t.Run("should keep ratings within bounds", func(t *testing.T) {
+		s, err := NewSoftReset(1, 200)
+		require.NoError(t, err)
+
+		assert.Equal(t, user.MinRating, s.Rating(50, 1500))
+	})
+
+	t.Run("should raise the deviation but not lower it", func(t *testing.T) {
+		s, err := NewSoftReset(0.5, 200)
+		require.NoError(t, err)
+
+		r := &user.Rating{}
+		require.NoError(t, r.Initialize(1, enums.TimeControlBlitz))
+
+		r.Rating, r.Deviation = 1900, 60
+		require.NoError(t, s.Apply(r, 1500))
+		assert.Equal(t, 1700, r.Rating)
+		assert.InDelta(t, 200, r.Deviation, 0)
+
+		r.Deviation = 300
+		require.NoError(t, s.Apply(r, 1500))
+		assert.Equal(t, 1600, r.Rating)
+		assert.InDelta(t, 300, r.Deviation, 0)
+	})
+
+	t.Run("should reject invalid settings", func(t *testing.T) {
+		_, err := NewSoftReset(1.5, 200)
+		require.ErrorIs(t, err, domainerrors.ErrInvalidRatingChange)
+
+		_, err = NewSoftReset(0.5, 0)
+		require.ErrorIs(t, err, domainerrors.ErrInvalidRatingChange)
+	})
+}
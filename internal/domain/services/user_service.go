@@ -2,60 +2,128 @@ package services
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
+
 	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
-	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/interfaces"
-	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/password"
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/services/namepolicy"
 	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/tag"
 )
 
-type UserService struct {
-	hasher password.Hasher
+const uniqueTagAttempts = 5
 
-	repo        user.Repository
-	profileRepo user.ProfileRepository
+type UserService struct {
+	repo           user.Repository
+	profileRepo    user.ProfileRepository
+	tagHistoryRepo user.TagHistoryRepository
+	namePolicy     *namepolicy.Policy
 }
 
-func NewUserService(hasher password.Hasher, repo user.Repository, profileRepository user.ProfileRepository) *UserService {
+var _ user.Service = new(UserService)
+
+func NewUserService(
+	repo user.Repository,
+	profileRepository user.ProfileRepository,
+	tagHistoryRepository user.TagHistoryRepository,
+	namePolicy *namepolicy.Policy,
+) *UserService {
 	return &UserService{
-		hasher:      hasher,
-		repo:        repo,
-		profileRepo: profileRepository,
+		repo:           repo,
+		profileRepo:    profileRepository,
+		tagHistoryRepo: tagHistoryRepository,
+		namePolicy:     namePolicy,
 	}
 }
 
-func (s *UserService) InitializeUserData(ctx context.Context, user *user.User, profile *user.Profile) (*user.User, *user.Profile, error) {
-	user.Initialize()
+func (s *UserService) CreateUser(ctx context.Context, u *user.User, p *user.Profile) (*user.User, *user.Profile, error) {
+	u.Initialize()
+
+	createdUser, err := s.repo.Create(ctx, u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p.Initialize(createdUser.ID())
 
-	profile.Initialize(u.ID())
+	createdProfile, err := s.profileRepo.Create(ctx, p)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	return u, p, nil
+	return createdUser, createdProfile, nil
 }
 
-//func (s *UserService) SecureUser(u *user.User, plainPassword password.PlainPassword) error {
-//	hash, err := s.hasher.Hash(u.Password())
-//	if err != nil {
-//		return err
-//	}
-//
-//	u.HashPassword(hash)
-//
-//	return nil
-//}
-
-func BuildUserTag(rawTag *string) (*tag.Tag, error) {
-	if rawTag == nil {
+// BuildUserTag parses rawTag, or generates a tag when it is nil, that userID may take: the name policy allows it
+// and no other user has it or holds it as an alias. A generated tag that cannot be taken is generated again. Pass 0
+// as userID for a new user.
+func (s *UserService) BuildUserTag(ctx context.Context, userID int64, rawTag *string) (*tag.Tag, error) {
+	if rawTag != nil {
+		parsedTag, err := tag.New(*rawTag)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domainerrors.ErrInvalidTag, err)
+		}
+
+		if err = s.namePolicy.Check(parsedTag.Value()); err != nil {
+			return nil, err
+		}
+
+		available, err := s.tagAvailable(ctx, userID, parsedTag)
+		if err != nil {
+			return nil, err
+		}
+
+		if !available {
+			return nil, domainerrors.ErrUsernameUnavailable
+		}
+
+		return parsedTag, nil
+	}
+
+	for i := 0; i < uniqueTagAttempts; i++ {
 		generatedTag, err := tag.Generate()
 		if err != nil {
 			return nil, err
 		}
 
-		return generatedTag, nil
+		if s.namePolicy.Check(generatedTag.Value()) != nil {
+			continue
+		}
+
+		available, err := s.tagAvailable(ctx, userID, generatedTag)
+		if err != nil {
+			return nil, err
+		}
+
+		if available {
+			return generatedTag, nil
+		}
 	}
 
-	parsedTag, err := tag.New(*rawTag)
-	if err != nil {
-		return nil, err
+	return nil, fmt.Errorf("failed to generate a unique tag after %d attempts", uniqueTagAttempts)
+}
+
+func (s *UserService) tagAvailable(ctx context.Context, userID int64, t *tag.Tag) (bool, error) {
+	holder, err := s.repo.GetByTag(ctx, t.Value())
+
+	switch {
+	case errors.Is(err, domainerrors.ErrUserNotFound):
+	case err != nil:
+		return false, err
+	case holder.ID() != userID:
+		return false, nil
 	}
 
-	return parsedTag, nil
+	alias, err := s.tagHistoryRepo.GetActive(ctx, t.Value(), time.Now())
+
+	switch {
+	case errors.Is(err, domainerrors.ErrUserNotFound):
+		return true, nil
+	case err != nil:
+		return false, err
+	default:
+		// Users may take back their own released tags.
+		return alias.UserID == userID, nil
+	}
 }
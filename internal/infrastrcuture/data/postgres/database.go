@@ -2,10 +2,26 @@ package postgres
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+type txKey struct{}
+
+type txState struct {
+	tx          pgx.Tx
+	afterCommit []func(ctx context.Context)
+}
+
+type Querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
 type Database struct {
 	pool *pgxpool.Pool
 }
@@ -24,6 +40,66 @@ func (d *Database) Pool() *pgxpool.Pool {
 	return d.pool
 }
 
+// Querier returns the transaction bound to ctx by WithinTx or the pool when there is none.
+func (d *Database) Querier(ctx context.Context) Querier {
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		return state.tx
+	}
+
+	return d.pool
+}
+
+// InTx reports whether ctx carries a transaction started by WithinTx.
+func InTx(ctx context.Context) bool {
+	_, ok := ctx.Value(txKey{}).(*txState)
+
+	return ok
+}
+
+// AfterCommit defers fn until the transaction bound to ctx commits and drops it on rollback.
+// Without a transaction fn runs immediately.
+func AfterCommit(ctx context.Context, fn func(ctx context.Context)) {
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		state.afterCommit = append(state.afterCommit, fn)
+
+		return
+	}
+
+	fn(ctx)
+}
+
+// WithinTx runs fn in a transaction. Nested calls reuse the outer transaction.
+func (d *Database) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if InTx(ctx) {
+		return fn(ctx)
+	}
+
+	tx, err := d.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	state := &txState{tx: tx}
+
+	if err = fn(context.WithValue(ctx, txKey{}, state)); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	for _, callback := range state.afterCommit {
+		callback(ctx)
+	}
+
+	return nil
+}
+
 func (d *Database) Shutdown(_ context.Context) error {
 	d.Pool().Close()
 	return nil
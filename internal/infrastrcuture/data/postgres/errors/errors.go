@@ -4,6 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	uniqueViolationCode     = "23505"
+	foreignKeyViolationCode = "23503"
 )
 
 type ErrMapper func(err error) error
@@ -23,3 +30,23 @@ func WrapWithMapper(op string, err error, mapper ErrMapper) error {
 
 	return fmt.Errorf("%s: unexpected error: %w", op, err)
 }
+
+// UniqueViolation reports the name of the violated constraint when err is a unique violation.
+func UniqueViolation(err error) (string, bool) {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+		return pgErr.ConstraintName, true
+	}
+
+	return "", false
+}
+
+// ForeignKeyViolation reports the name of the violated constraint when err is a foreign key violation.
+func ForeignKeyViolation(err error) (string, bool) {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode {
+		return pgErr.ConstraintName, true
+	}
+
+	return "", false
+}
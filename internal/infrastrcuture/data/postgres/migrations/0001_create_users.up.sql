@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS users
+(
+    id                BIGSERIAL PRIMARY KEY,
+    tag               VARCHAR(10)  NOT NULL,
+    email             VARCHAR(254) NOT NULL,
+    password          TEXT         NOT NULL,
+    status            VARCHAR(16)  NOT NULL DEFAULT 'active'
+        CHECK (status IN ('active', 'suspended', 'banned', 'deleted')),
+    is_verified       BOOLEAN      NOT NULL DEFAULT FALSE,
+    is_premium        BOOLEAN      NOT NULL DEFAULT FALSE,
+    email_verified_at TIMESTAMPTZ,
+    premium_until     TIMESTAMPTZ,
+    language          VARCHAR(16)  NOT NULL,
+    last_active_at    TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    last_login_at     TIMESTAMPTZ,
+    updated_at        TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    created_at        TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS users_email_uidx ON users (email);
+CREATE UNIQUE INDEX IF NOT EXISTS users_tag_uidx ON users (tag);
+CREATE INDEX IF NOT EXISTS users_status_idx ON users (status);
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS profiles
+(
+    user_id             BIGINT PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    public_name         VARCHAR(15) NOT NULL,
+    bio                 TEXT,
+    country_code        VARCHAR(2),
+    city                VARCHAR(128),
+    birth_date          DATE,
+    avatar_url          TEXT,
+    cover_image_url     TEXT,
+    is_public           BOOLEAN     NOT NULL DEFAULT TRUE,
+    show_country        BOOLEAN     NOT NULL DEFAULT TRUE,
+    website_url         TEXT,
+    twitch_username     VARCHAR(64),
+    youtube_channel_url TEXT,
+    created_at          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at          TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS profiles_public_name_uidx ON profiles (public_name);
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS ratings
+(
+    id            UUID PRIMARY KEY,
+    user_id       BIGINT      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    time_control  VARCHAR(16) NOT NULL
+        CHECK (time_control IN ('bullet', 'blitz', 'rapid', 'classical', 'correspondence')),
+    rating        INTEGER     NOT NULL DEFAULT 1200,
+    peak_rating   INTEGER     NOT NULL DEFAULT 1200,
+    lowest_rating INTEGER     NOT NULL DEFAULT 1200,
+    games_played  INTEGER     NOT NULL DEFAULT 0,
+    wins          INTEGER     NOT NULL DEFAULT 0,
+    losses        INTEGER     NOT NULL DEFAULT 0,
+    draws         INTEGER     NOT NULL DEFAULT 0,
+    last_game_at  TIMESTAMPTZ,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS ratings_user_time_control_uidx ON ratings (user_id, time_control);
+CREATE INDEX IF NOT EXISTS ratings_leaderboard_idx ON ratings (time_control, rating DESC, user_id);
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS rating_history
+(
+    id            UUID PRIMARY KEY,
+    user_id       BIGINT      NOT NULL REFERENCES users (id),
+    time_control  VARCHAR(16) NOT NULL
+        CHECK (time_control IN ('bullet', 'blitz', 'rapid', 'classical', 'correspondence')),
+    old_rating    INTEGER     NOT NULL,
+    new_rating    INTEGER     NOT NULL,
+    rating_range  INTEGER     NOT NULL DEFAULT 0,
+    game_id       UUID,
+    change_reason VARCHAR(16) NOT NULL
+        CHECK (change_reason IN ('game', 'adjustment', 'season_reset', 'penalty')),
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS rating_history_user_time_control_idx
+    ON rating_history (user_id, time_control, created_at DESC);
+CREATE INDEX IF NOT EXISTS rating_history_game_idx ON rating_history (game_id) WHERE game_id IS NOT NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE ratings
+    DROP COLUMN IF EXISTS deviation,
+    DROP COLUMN IF EXISTS volatility;
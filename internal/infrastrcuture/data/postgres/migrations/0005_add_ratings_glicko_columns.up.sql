@@ -0,0 +1,3 @@
+ALTER TABLE ratings
+    ADD COLUMN IF NOT EXISTS deviation  DOUBLE PRECISION NOT NULL DEFAULT 350,
+    ADD COLUMN IF NOT EXISTS volatility DOUBLE PRECISION NOT NULL DEFAULT 0.06;
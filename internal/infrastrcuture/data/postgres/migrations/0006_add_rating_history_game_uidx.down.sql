@@ -0,0 +1 @@
+DROP INDEX IF EXISTS rating_history_user_game_uidx;
@@ -0,0 +1,2 @@
+CREATE UNIQUE INDEX IF NOT EXISTS rating_history_user_game_uidx
+    ON rating_history (user_id, game_id) WHERE game_id IS NOT NULL;
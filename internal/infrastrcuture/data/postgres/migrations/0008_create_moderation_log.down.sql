@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS moderation_log;
+
+DROP INDEX IF EXISTS users_suspended_until_idx;
+
+ALTER TABLE users DROP COLUMN IF EXISTS suspended_until;
@@ -0,0 +1,16 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS suspended_until TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS users_suspended_until_idx ON users (suspended_until) WHERE status = 'suspended';
+
+CREATE TABLE IF NOT EXISTS moderation_log
+(
+    id              BIGSERIAL PRIMARY KEY,
+    user_id         BIGINT      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    action          VARCHAR(16) NOT NULL CHECK (action IN ('suspend', 'ban', 'reinstate')),
+    actor_id        BIGINT,
+    reason          TEXT        NOT NULL,
+    suspended_until TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS moderation_log_user_idx ON moderation_log (user_id, id DESC);
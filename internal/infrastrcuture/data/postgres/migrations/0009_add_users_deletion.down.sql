@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS users_pending_anonymization_idx;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS anonymized_at,
+    DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,6 @@
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS deleted_at    TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS anonymized_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS users_pending_anonymization_idx ON users (deleted_at)
+    WHERE status = 'deleted' AND anonymized_at IS NULL;
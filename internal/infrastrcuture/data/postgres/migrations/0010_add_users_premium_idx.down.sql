@@ -0,0 +1 @@
+DROP INDEX IF EXISTS users_premium_until_idx;
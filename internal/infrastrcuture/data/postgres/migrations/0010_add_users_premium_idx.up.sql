@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS users_premium_until_idx ON users (premium_until) WHERE is_premium;
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS tag_history
+(
+    id          BIGSERIAL PRIMARY KEY,
+    user_id     BIGINT      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    tag         VARCHAR(10) NOT NULL,
+    released_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at  TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS tag_history_tag_idx ON tag_history (tag, expires_at DESC);
+
+CREATE INDEX IF NOT EXISTS tag_history_user_idx ON tag_history (user_id, released_at DESC);
@@ -0,0 +1,22 @@
+-- Refund rows cannot be kept under the earlier check and unique index, and dropping them would rewrite ratings
+-- history, so the rollback refuses while any exist instead of losing them. Without refunds it restores 0011.
+DO
+$$
+    BEGIN
+        IF EXISTS (SELECT 1 FROM rating_history WHERE change_reason = 'refund') THEN
+            RAISE EXCEPTION 'rating_history has refund rows, migration 0012 cannot be rolled back';
+        END IF;
+    END
+$$;
+
+DROP INDEX IF EXISTS rating_history_user_game_reason_uidx;
+CREATE UNIQUE INDEX IF NOT EXISTS rating_history_user_game_uidx
+    ON rating_history (user_id, game_id) WHERE game_id IS NOT NULL;
+
+ALTER TABLE rating_history DROP CONSTRAINT IF EXISTS rating_history_change_reason_check;
+ALTER TABLE rating_history ADD CONSTRAINT rating_history_change_reason_check
+    CHECK (change_reason IN ('game', 'adjustment', 'season_reset', 'penalty'));
+
+ALTER TABLE rating_history
+    DROP COLUMN IF EXISTS note,
+    DROP COLUMN IF EXISTS actor_id;
@@ -0,0 +1,11 @@
+ALTER TABLE rating_history ADD COLUMN IF NOT EXISTS actor_id BIGINT;
+ALTER TABLE rating_history ADD COLUMN IF NOT EXISTS note TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE rating_history DROP CONSTRAINT IF EXISTS rating_history_change_reason_check;
+ALTER TABLE rating_history ADD CONSTRAINT rating_history_change_reason_check
+    CHECK (change_reason IN ('game', 'adjustment', 'season_reset', 'penalty', 'refund'));
+
+-- A game is recorded once per player and refunded at most once, so both rows share the game id.
+DROP INDEX IF EXISTS rating_history_user_game_uidx;
+CREATE UNIQUE INDEX IF NOT EXISTS rating_history_user_game_reason_uidx
+    ON rating_history (user_id, game_id, change_reason) WHERE game_id IS NOT NULL;
@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS seasons
+(
+    id         BIGSERIAL PRIMARY KEY,
+    name       VARCHAR(64) NOT NULL,
+    started_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    ended_at   TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS seasons_current_uidx ON seasons ((ended_at IS NULL)) WHERE ended_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS season_standings
+(
+    season_id    BIGINT           NOT NULL REFERENCES seasons (id) ON DELETE CASCADE,
+    time_control VARCHAR(16)      NOT NULL,
+    user_id      BIGINT           NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    rank         INTEGER          NOT NULL,
+    rating       INTEGER          NOT NULL,
+    deviation    DOUBLE PRECISION NOT NULL,
+    games_played INTEGER          NOT NULL,
+    wins         INTEGER          NOT NULL,
+    losses       INTEGER          NOT NULL,
+    draws        INTEGER          NOT NULL,
+    PRIMARY KEY (season_id, time_control, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS season_standings_rank_idx ON season_standings (season_id, time_control, rating DESC, user_id);
+
+CREATE TABLE IF NOT EXISTS season_resets
+(
+    season_id    BIGINT           NOT NULL REFERENCES seasons (id) ON DELETE CASCADE,
+    time_control VARCHAR(16)      NOT NULL,
+    mean_rating  DOUBLE PRECISION NOT NULL,
+    last_user_id BIGINT           NOT NULL DEFAULT 0,
+    completed_at TIMESTAMPTZ,
+    PRIMARY KEY (season_id, time_control)
+);
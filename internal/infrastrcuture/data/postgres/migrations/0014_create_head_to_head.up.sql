@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS head_to_head
+(
+    user_id      BIGINT      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    opponent_id  BIGINT      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    time_control VARCHAR(16) NOT NULL,
+    wins         INTEGER     NOT NULL DEFAULT 0,
+    losses       INTEGER     NOT NULL DEFAULT 0,
+    draws        INTEGER     NOT NULL DEFAULT 0,
+    last_game_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (user_id, opponent_id, time_control),
+    CHECK (user_id <> opponent_id)
+);
+
+CREATE INDEX IF NOT EXISTS head_to_head_user_time_control_idx ON head_to_head (user_id, time_control);
@@ -0,0 +1,48 @@
+package migrations
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	t.Run("should load embedded migrations ordered by version", func(t *testing.T) {
+		migrations, err := load(files)
+		require.NoError(t, err)
+		require.NotEmpty(t, migrations)
+
+		for i, migration := range migrations {
+			assert.NotEmpty(t, migration.Up)
+			assert.NotEmpty(t, migration.Down)
+
+			if i > 0 {
+				assert.Less(t, migrations[i-1].Version, migration.Version)
+			}
+		}
+	})
+
+	t.Run("should return error when down migration is missing", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"0001_create_users.up.sql": {Data: []byte("CREATE TABLE users (id BIGINT);")},
+		}
+
+		migrations, err := load(fsys)
+		assert.Error(t, err)
+		assert.Nil(t, migrations)
+	})
+
+	t.Run("should return error when version is invalid", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"first_create_users.up.sql":   {Data: []byte("CREATE TABLE users (id BIGINT);")},
+			"first_create_users.down.sql": {Data: []byte("DROP TABLE users;")},
+		}
+
+		migrations, err := load(fsys)
+		assert.Error(t, err)
+		assert.Nil(t, migrations)
+		assert.Contains(t, err.Error(), "invalid migration version")
+	})
+}
@@ -0,0 +1,127 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/postgres"
+	postgreserrors "github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/postgres/errors"
+)
+
+type PostgresHeadToHeadRepo struct {
+	database *postgres.Database
+}
+
+var _ user.HeadToHeadRepository = new(PostgresHeadToHeadRepo)
+
+func NewPostgresHeadToHeadRepository(db *postgres.Database) *PostgresHeadToHeadRepo {
+	return &PostgresHeadToHeadRepo{
+		database: db,
+	}
+}
+
+func (r *PostgresHeadToHeadRepo) Add(ctx context.Context, records ...*user.HeadToHead) error {
+	query := `
+		INSERT INTO head_to_head (user_id, opponent_id, time_control, wins, losses, draws, last_game_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (user_id, opponent_id, time_control) DO UPDATE SET
+			wins = head_to_head.wins + EXCLUDED.wins,
+			losses = head_to_head.losses + EXCLUDED.losses,
+			draws = head_to_head.draws + EXCLUDED.draws,
+			last_game_at = GREATEST(head_to_head.last_game_at, EXCLUDED.last_game_at)
+	`
+
+	for _, h := range records {
+		_, err := r.database.Querier(ctx).Exec(ctx, query,
+			h.UserID,
+			h.OpponentID,
+			h.TimeControl,
+			h.Wins,
+			h.Losses,
+			h.Draws,
+			h.LastGameAt,
+		)
+		if err != nil {
+			return postgreserrors.WrapWithMapper("PostgresHeadToHeadRepo.Add", err, mapUserForeignKey)
+		}
+	}
+
+	return nil
+}
+
+func (r *PostgresHeadToHeadRepo) GetBetween(ctx context.Context, userID, opponentID int64) ([]*user.HeadToHead, error) {
+	query := `
+		SELECT user_id, opponent_id, time_control, wins, losses, draws, last_game_at
+		FROM head_to_head
+		WHERE user_id = $1 AND opponent_id = $2
+		ORDER BY time_control
+	`
+
+	rows, err := r.database.Querier(ctx).Query(ctx, query, userID, opponentID)
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresHeadToHeadRepo.GetBetween query", err, nil)
+	}
+
+	records, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*user.HeadToHead, error) {
+		return scanHeadToHead(row)
+	})
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresHeadToHeadRepo.GetBetween scan", err, nil)
+	}
+
+	return records, nil
+}
+
+// ListOpponents breaks ties in games played by the most recent game, then by opponent id.
+func (r *PostgresHeadToHeadRepo) ListOpponents(
+	ctx context.Context,
+	userID int64,
+	timeControl enums.TimeControl,
+	limit int,
+) ([]*user.HeadToHead, error) {
+	query := `
+		SELECT user_id, opponent_id, $2::text, SUM(wins), SUM(losses), SUM(draws), MAX(last_game_at)
+		FROM head_to_head
+		WHERE user_id = $1 AND ($2 = '' OR time_control = $2)
+			AND opponent_id NOT IN (SELECT id FROM users WHERE status = 'deleted')
+		GROUP BY user_id, opponent_id
+		ORDER BY SUM(wins + losses + draws) DESC, MAX(last_game_at) DESC, opponent_id
+		LIMIT $3
+	`
+
+	rows, err := r.database.Querier(ctx).Query(ctx, query, userID, string(timeControl), limit)
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresHeadToHeadRepo.ListOpponents query", err, nil)
+	}
+
+	records, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*user.HeadToHead, error) {
+		return scanHeadToHead(row)
+	})
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresHeadToHeadRepo.ListOpponents scan", err, nil)
+	}
+
+	return records, nil
+}
+
+func scanHeadToHead(row pgx.Row) (*user.HeadToHead, error) {
+	var h user.HeadToHead
+
+	err := row.Scan(
+		&h.UserID,
+		&h.OpponentID,
+		&h.TimeControl,
+		&h.Wins,
+		&h.Losses,
+		&h.Draws,
+		&h.LastGameAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &h, nil
+}
@@ -0,0 +1,79 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+)
+
+func TestPostgresHeadToHeadRepo(t *testing.T) {
+	db := openTestDatabase(t)
+	repo := NewPostgresHeadToHeadRepository(db)
+
+	playGame := func(
+		t *testing.T,
+		ctx context.Context,
+		whiteID, blackID int64,
+		timeControl enums.TimeControl,
+		outcome enums.GameOutcome,
+		playedAt time.Time,
+	) {
+		t.Helper()
+
+		white, black, err := user.HeadToHeadOfGame(whiteID, blackID, timeControl, outcome, playedAt)
+		require.NoError(t, err)
+		require.NoError(t, repo.Add(ctx, white, black))
+	}
+
+	t.Run("should accumulate the record of a pair per time control", func(t *testing.T) {
+		withRollback(t, db, func(ctx context.Context) {
+			first, second := createTestUser(t, ctx, db), createTestUser(t, ctx, db)
+			playedAt := time.Now().Truncate(time.Microsecond)
+
+			playGame(t, ctx, first, second, enums.TimeControlBlitz, enums.GameOutcomeWhiteWins, playedAt)
+			playGame(t, ctx, second, first, enums.TimeControlBlitz, enums.GameOutcomeDraw, playedAt.Add(time.Hour))
+			playGame(t, ctx, second, first, enums.TimeControlRapid, enums.GameOutcomeWhiteWins, playedAt)
+
+			records, err := repo.GetBetween(ctx, first, second)
+			require.NoError(t, err)
+			require.Len(t, records, 2)
+
+			blitz := records[0]
+			assert.Equal(t, enums.TimeControlBlitz, blitz.TimeControl)
+			assert.Equal(t, 1, blitz.Wins)
+			assert.Equal(t, 1, blitz.Draws)
+			assert.True(t, playedAt.Add(time.Hour).Equal(blitz.LastGameAt))
+
+			assert.Equal(t, 1, records[1].Losses)
+		})
+	})
+
+	t.Run("should list the most played opponents first", func(t *testing.T) {
+		withRollback(t, db, func(ctx context.Context) {
+			userID, frequent, rare := createTestUser(t, ctx, db), createTestUser(t, ctx, db), createTestUser(t, ctx, db)
+			playedAt := time.Now()
+
+			playGame(t, ctx, userID, frequent, enums.TimeControlBlitz, enums.GameOutcomeWhiteWins, playedAt)
+			playGame(t, ctx, userID, frequent, enums.TimeControlRapid, enums.GameOutcomeBlackWins, playedAt)
+			playGame(t, ctx, userID, rare, enums.TimeControlBlitz, enums.GameOutcomeDraw, playedAt)
+
+			opponents, err := repo.ListOpponents(ctx, userID, "", 10)
+			require.NoError(t, err)
+			require.Len(t, opponents, 2)
+			assert.Equal(t, frequent, opponents[0].OpponentID)
+			assert.Equal(t, 2, opponents[0].Games())
+			assert.Equal(t, rare, opponents[1].OpponentID)
+
+			opponents, err = repo.ListOpponents(ctx, userID, enums.TimeControlRapid, 10)
+			require.NoError(t, err)
+			require.Len(t, opponents, 1)
+			assert.Equal(t, enums.TimeControlRapid, opponents[0].TimeControl)
+		})
+	})
+}
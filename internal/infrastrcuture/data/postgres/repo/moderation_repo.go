@@ -0,0 +1,80 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/postgres"
+	postgreserrors "github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/postgres/errors"
+)
+
+type PostgresModerationRepo struct {
+	database *postgres.Database
+}
+
+var _ user.ModerationRepository = new(PostgresModerationRepo)
+
+func NewPostgresModerationRepository(db *postgres.Database) *PostgresModerationRepo {
+	return &PostgresModerationRepo{
+		database: db,
+	}
+}
+
+func (r *PostgresModerationRepo) Create(ctx context.Context, record *user.ModerationRecord) error {
+	query := `
+		INSERT INTO moderation_log (user_id, action, actor_id, reason, suspended_until)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at
+	`
+
+	err := r.database.Querier(ctx).QueryRow(ctx, query,
+		record.UserID,
+		record.Action,
+		record.ActorID,
+		record.Reason,
+		record.SuspendedUntil,
+	).Scan(&record.ID, &record.CreatedAt)
+	if err != nil {
+		return postgreserrors.WrapWithMapper("PostgresModerationRepo.Create", err, nil)
+	}
+
+	return nil
+}
+
+func (r *PostgresModerationRepo) ListByUserID(ctx context.Context, userID int64, limit int) ([]*user.ModerationRecord, error) {
+	query := `
+		SELECT id, user_id, action, actor_id, reason, suspended_until, created_at
+		FROM moderation_log
+		WHERE user_id = $1
+		ORDER BY id DESC
+		LIMIT NULLIF($2, 0)
+	`
+
+	rows, err := r.database.Querier(ctx).Query(ctx, query, userID, limit)
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresModerationRepo.ListByUserID query", err, nil)
+	}
+
+	records, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*user.ModerationRecord, error) {
+		var record user.ModerationRecord
+
+		err := row.Scan(
+			&record.ID,
+			&record.UserID,
+			&record.Action,
+			&record.ActorID,
+			&record.Reason,
+			&record.SuspendedUntil,
+			&record.CreatedAt,
+		)
+
+		return &record, err
+	})
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresModerationRepo.ListByUserID scan", err, nil)
+	}
+
+	return records, nil
+}
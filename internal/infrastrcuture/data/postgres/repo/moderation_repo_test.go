@@ -0,0 +1,84 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/postgres"
+)
+
+func TestPostgresModerationRepo(t *testing.T) {
+	db := openTestDatabase(t)
+	repo := NewPostgresModerationRepository(db)
+	users := NewPostgresSessionRepository(db, postgres.NewUserQueryFactory())
+
+	t.Run("should list records newest first", func(t *testing.T) {
+		withRollback(t, db, func(ctx context.Context) {
+			userID := createTestUser(t, ctx, db)
+			actorID := int64(42)
+			until := time.Now().Add(time.Hour).Truncate(time.Microsecond)
+
+			suspend := &user.ModerationRecord{
+				UserID:         userID,
+				Action:         enums.ModerationActionSuspend,
+				ActorID:        &actorID,
+				Reason:         "spam",
+				SuspendedUntil: &until,
+			}
+			require.NoError(t, repo.Create(ctx, suspend))
+			assert.NotZero(t, suspend.ID)
+
+			reinstate := &user.ModerationRecord{
+				UserID: userID,
+				Action: enums.ModerationActionReinstate,
+				Reason: "suspension expired",
+			}
+			require.NoError(t, repo.Create(ctx, reinstate))
+
+			records, err := repo.ListByUserID(ctx, userID, 10)
+			require.NoError(t, err)
+			require.Len(t, records, 2)
+
+			assert.Equal(t, enums.ModerationActionReinstate, records[0].Action)
+			assert.Nil(t, records[0].ActorID)
+			assert.Equal(t, actorID, *records[1].ActorID)
+			assert.True(t, until.Equal(*records[1].SuspendedUntil))
+		})
+	})
+
+	t.Run("should find users whose suspension expired", func(t *testing.T) {
+		withRollback(t, db, func(ctx context.Context) {
+			userID := createTestUser(t, ctx, db)
+
+			u, err := users.LockByID(ctx, userID)
+			require.NoError(t, err)
+			require.NoError(t, u.Suspend("spam", time.Now().Add(time.Hour)))
+
+			_, err = users.Update(ctx, u)
+			require.NoError(t, err)
+
+			status := enums.UserStatusSuspended
+			before := time.Now().Add(2 * time.Hour)
+
+			found, err := users.Find(ctx, &user.Criteria{Status: &status, SuspendedUntilBefore: &before, Limit: 10})
+			require.NoError(t, err)
+			require.NotEmpty(t, found)
+			assert.Equal(t, userID, found[len(found)-1].ID())
+
+			now := time.Now()
+
+			found, err = users.Find(ctx, &user.Criteria{Status: &status, SuspendedUntilBefore: &now})
+			require.NoError(t, err)
+
+			for _, f := range found {
+				assert.NotEqual(t, userID, f.ID())
+			}
+		})
+	})
+}
@@ -0,0 +1,158 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/outbox"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/events"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/postgres"
+	postgreserrors "github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/postgres/errors"
+)
+
+// outboxLockKey makes sure a single relay publishes at a time, which keeps messages in order.
+const outboxLockKey = 727275
+
+type PostgresOutboxRepo struct {
+	database *postgres.Database
+}
+
+var (
+	_ events.Outbox = new(PostgresOutboxRepo)
+	_ outbox.Store  = new(PostgresOutboxRepo)
+)
+
+func NewPostgresOutboxRepository(db *postgres.Database) *PostgresOutboxRepo {
+	return &PostgresOutboxRepo{
+		database: db,
+	}
+}
+
+func (r *PostgresOutboxRepo) Append(ctx context.Context, evts ...events.Event) error {
+	query := `
+		INSERT INTO outbox (event_id, event_type, aggregate_id, payload, created_at)
+		VALUES ($1, $2, $3, $4, clock_timestamp())
+	`
+
+	for _, event := range evts {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("PostgresOutboxRepo.Append marshal %s: %w", event.Type(), err)
+		}
+
+		eventID, err := uuid.NewRandom()
+		if err != nil {
+			return fmt.Errorf("PostgresOutboxRepo.Append event id: %w", err)
+		}
+
+		_, err = r.database.Querier(ctx).Exec(ctx, query, eventID, event.Type(), event.AggregateID(), payload)
+		if err != nil {
+			return postgreserrors.WrapWithMapper("PostgresOutboxRepo.Append", err, nil)
+		}
+	}
+
+	return nil
+}
+
+func (r *PostgresOutboxRepo) Lock(ctx context.Context) (bool, error) {
+	var locked bool
+
+	err := r.database.Querier(ctx).QueryRow(ctx, `SELECT pg_try_advisory_xact_lock($1)`, outboxLockKey).Scan(&locked)
+	if err != nil {
+		return false, postgreserrors.WrapWithMapper("PostgresOutboxRepo.Lock", err, nil)
+	}
+
+	return locked, nil
+}
+
+// FetchPending stops before the first message younger than commitLag instead of skipping it, so a message is
+// never returned ahead of one with a lower id. created_at is the time of the insert rather than of the
+// transaction start, so the lag counts from when the id was taken.
+func (r *PostgresOutboxRepo) FetchPending(
+	ctx context.Context,
+	limit int,
+	commitLag time.Duration,
+) ([]*outbox.Message, error) {
+	query := `
+		SELECT id, event_id, event_type, aggregate_id, payload, attempts, next_attempt_at, created_at
+		FROM outbox
+		WHERE delivered_at IS NULL
+		  AND id < COALESCE((
+			SELECT MIN(id) FROM outbox
+			WHERE delivered_at IS NULL AND created_at > clock_timestamp() - make_interval(secs => $2)
+		  ), 'Infinity'::numeric)
+		ORDER BY id
+		LIMIT $1
+	`
+
+	rows, err := r.database.Querier(ctx).Query(ctx, query, limit, commitLag.Seconds())
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresOutboxRepo.FetchPending query", err, nil)
+	}
+
+	messages, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*outbox.Message, error) {
+		var msg outbox.Message
+
+		err := row.Scan(
+			&msg.ID,
+			&msg.EventID,
+			&msg.EventType,
+			&msg.AggregateID,
+			&msg.Payload,
+			&msg.Attempts,
+			&msg.NextAttemptAt,
+			&msg.CreatedAt,
+		)
+
+		return &msg, err
+	})
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresOutboxRepo.FetchPending scan", err, nil)
+	}
+
+	return messages, nil
+}
+
+func (r *PostgresOutboxRepo) MarkDelivered(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query := `UPDATE outbox SET delivered_at = NOW(), last_error = NULL WHERE id = ANY($1)`
+
+	if _, err := r.database.Querier(ctx).Exec(ctx, query, ids); err != nil {
+		return postgreserrors.WrapWithMapper("PostgresOutboxRepo.MarkDelivered", err, nil)
+	}
+
+	return nil
+}
+
+func (r *PostgresOutboxRepo) MarkFailed(ctx context.Context, id int64, cause string, retryAt time.Time) error {
+	query := `
+		UPDATE outbox SET
+			attempts = attempts + 1,
+			last_error = $1,
+			next_attempt_at = $2
+		WHERE id = $3
+	`
+
+	if _, err := r.database.Querier(ctx).Exec(ctx, query, cause, retryAt, id); err != nil {
+		return postgreserrors.WrapWithMapper("PostgresOutboxRepo.MarkFailed", err, nil)
+	}
+
+	return nil
+}
+
+func (r *PostgresOutboxRepo) PurgeDelivered(ctx context.Context, before time.Time) (int64, error) {
+	tag, err := r.database.Querier(ctx).Exec(ctx, `DELETE FROM outbox WHERE delivered_at < $1`, before)
+	if err != nil {
+		return 0, postgreserrors.WrapWithMapper("PostgresOutboxRepo.PurgeDelivered", err, nil)
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,63 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresOutboxRepo(t *testing.T) {
+	db := openTestDatabase(t)
+	repo := NewPostgresOutboxRepository(db)
+
+	record := func(t *testing.T, ctx context.Context, age time.Duration) int64 {
+		t.Helper()
+
+		var id int64
+
+		err := db.Querier(ctx).QueryRow(ctx, `
+			INSERT INTO outbox (event_id, event_type, aggregate_id, payload, created_at)
+			VALUES ($1, 'test', 1, '{}', clock_timestamp() - make_interval(secs => $2))
+			RETURNING id
+		`, uuid.New(), age.Seconds()).Scan(&id)
+		require.NoError(t, err)
+
+		return id
+	}
+
+	t.Run("should stop before the first message younger than the commit lag", func(t *testing.T) {
+		withRollback(t, db, func(ctx context.Context) {
+			_, err := db.Querier(ctx).Exec(ctx, `DELETE FROM outbox`)
+			require.NoError(t, err)
+
+			settled := record(t, ctx, time.Minute)
+			record(t, ctx, 0)
+			record(t, ctx, time.Minute)
+
+			messages, err := repo.FetchPending(ctx, 10, 5*time.Second)
+			require.NoError(t, err)
+
+			require.Len(t, messages, 1)
+			assert.Equal(t, settled, messages[0].ID)
+		})
+	})
+
+	t.Run("should return every pending message without a commit lag", func(t *testing.T) {
+		withRollback(t, db, func(ctx context.Context) {
+			_, err := db.Querier(ctx).Exec(ctx, `DELETE FROM outbox`)
+			require.NoError(t, err)
+
+			record(t, ctx, time.Minute)
+			record(t, ctx, 0)
+
+			messages, err := repo.FetchPending(ctx, 10, 0)
+			require.NoError(t, err)
+
+			assert.Len(t, messages, 2)
+		})
+	})
+}
@@ -0,0 +1,210 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/publicname"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/postgres"
+	postgreserrors "github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/postgres/errors"
+)
+
+const profileColumns = `
+	user_id, public_name, bio, country_code, city, birth_date, avatar_url, cover_image_url, is_public,
+	show_country, website_url, twitch_username, youtube_channel_url, created_at, updated_at
+`
+
+type PostgresProfileRepo struct {
+	database *postgres.Database
+}
+
+var _ user.ProfileRepository = new(PostgresProfileRepo)
+
+func NewPostgresProfileRepository(db *postgres.Database) *PostgresProfileRepo {
+	return &PostgresProfileRepo{
+		database: db,
+	}
+}
+
+func (r *PostgresProfileRepo) Create(ctx context.Context, p *user.Profile) (*user.Profile, error) {
+	query := `
+		INSERT INTO profiles (
+			user_id, public_name, bio, country_code, city, birth_date, avatar_url, cover_image_url,
+			is_public, show_country, website_url, twitch_username, youtube_channel_url
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+		)
+		RETURNING ` + profileColumns
+
+	row := r.database.Querier(ctx).QueryRow(ctx, query,
+		p.UserID,
+		p.PublicName.Value(),
+		p.Bio,
+		p.CountryCode,
+		p.City,
+		p.BirthDate,
+		p.AvatarURL,
+		p.CoverImageURL,
+		p.IsPublic,
+		p.ShowCountry,
+		p.WebsiteURL,
+		p.TwitchUsername,
+		p.YoutubeChannelURL,
+	)
+
+	profile, err := scanProfile(row)
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresProfileRepo.Create", err, mapProfileUniqueViolation)
+	}
+
+	return profile, nil
+}
+
+func (r *PostgresProfileRepo) GetByUserID(ctx context.Context, userID int64) (*user.Profile, error) {
+	query := `SELECT ` + profileColumns + ` FROM profiles WHERE user_id = $1`
+
+	row := r.database.Querier(ctx).QueryRow(ctx, query, userID)
+
+	profile, err := scanProfile(row)
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresProfileRepo.GetByUserID", err, mapUserNotFound)
+	}
+
+	return profile, nil
+}
+
+func (r *PostgresProfileRepo) Update(ctx context.Context, p *user.Profile) error {
+	query := `
+		UPDATE profiles SET
+			public_name = $1,
+			bio = $2,
+			country_code = $3,
+			city = $4,
+			birth_date = $5,
+			avatar_url = $6,
+			cover_image_url = $7,
+			is_public = $8,
+			show_country = $9,
+			website_url = $10,
+			twitch_username = $11,
+			youtube_channel_url = $12,
+			updated_at = NOW()
+		WHERE user_id = $13
+	`
+
+	tag, err := r.database.Querier(ctx).Exec(ctx, query,
+		p.PublicName.Value(),
+		p.Bio,
+		p.CountryCode,
+		p.City,
+		p.BirthDate,
+		p.AvatarURL,
+		p.CoverImageURL,
+		p.IsPublic,
+		p.ShowCountry,
+		p.WebsiteURL,
+		p.TwitchUsername,
+		p.YoutubeChannelURL,
+		p.UserID,
+	)
+	if err != nil {
+		return postgreserrors.WrapWithMapper("PostgresProfileRepo.Update", err, mapProfileUniqueViolation)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return postgreserrors.WrapWithMapper("PostgresProfileRepo.Update", pgx.ErrNoRows, mapUserNotFound)
+	}
+
+	return nil
+}
+
+func (r *PostgresProfileRepo) GetPublicProfiles(ctx context.Context, userIDs []int64) ([]*user.Profile, error) {
+	if len(userIDs) == 0 {
+		return []*user.Profile{}, nil
+	}
+
+	// The ids travel as a single array parameter, so the statement stays the same for any batch size. Banned users
+	// are left out like deleted ones, so a profile that got its owner banned is not shown until they are
+	// reinstated.
+	query := `
+		SELECT ` + profileColumns + `
+		FROM profiles
+		JOIN unnest($1::BIGINT[]) WITH ORDINALITY AS ids (id, position) ON ids.id = profiles.user_id
+		WHERE NOT EXISTS (
+			SELECT 1 FROM users WHERE users.id = profiles.user_id AND users.status IN ($2, $3)
+		)
+		ORDER BY ids.position
+	`
+
+	rows, err := r.database.Querier(ctx).Query(ctx, query, userIDs, enums.UserStatusDeleted, enums.UserStatusBanned)
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresProfileRepo.GetPublicProfiles query", err, nil)
+	}
+	defer rows.Close()
+
+	profiles := make([]*user.Profile, 0, len(userIDs))
+
+	for rows.Next() {
+		p, err := scanProfile(rows)
+		if err != nil {
+			return nil, postgreserrors.WrapWithMapper("PostgresProfileRepo.GetPublicProfiles scan row", err, nil)
+		}
+		profiles = append(profiles, p.PublicView())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresProfileRepo.GetPublicProfiles rows iteration", err, nil)
+	}
+
+	return profiles, nil
+}
+
+func mapProfileUniqueViolation(err error) error {
+	constraint, ok := postgreserrors.UniqueViolation(err)
+	if !ok {
+		return fmt.Errorf("scan error: %w", err)
+	}
+
+	if constraint == "profiles_public_name_uidx" {
+		return domainerrors.ErrUsernameUnavailable
+	}
+
+	return domainerrors.ErrUserAlreadyExists
+}
+
+func scanProfile(row pgx.Row) (*user.Profile, error) {
+	var (
+		p          user.Profile
+		publicName string
+	)
+
+	err := row.Scan(
+		&p.UserID,
+		&publicName,
+		&p.Bio,
+		&p.CountryCode,
+		&p.City,
+		&p.BirthDate,
+		&p.AvatarURL,
+		&p.CoverImageURL,
+		&p.IsPublic,
+		&p.ShowCountry,
+		&p.WebsiteURL,
+		&p.TwitchUsername,
+		&p.YoutubeChannelURL,
+		&p.CreatedAt,
+		&p.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	p.PublicName, _ = publicname.New(publicName)
+
+	return &p, nil
+}
@@ -0,0 +1,74 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/publicname"
+)
+
+func TestPostgresProfileRepo(t *testing.T) {
+	db := openTestDatabase(t)
+	repo := NewPostgresProfileRepository(db)
+
+	createProfile := func(t *testing.T, ctx context.Context, name string, isPublic bool) int64 {
+		t.Helper()
+
+		userID := createTestUser(t, ctx, db)
+
+		publicName, err := publicname.New(name)
+		require.NoError(t, err)
+
+		bio := "bio"
+
+		p := &user.Profile{PublicName: publicName, Bio: &bio}
+		p.Initialize(userID)
+		p.IsPublic = isPublic
+
+		_, err = repo.Create(ctx, p)
+		require.NoError(t, err)
+
+		return userID
+	}
+
+	t.Run("should return public views in request order skipping unknown users", func(t *testing.T) {
+		withRollback(t, db, func(ctx context.Context) {
+			public := createProfile(t, ctx, "public_player", true)
+			private := createProfile(t, ctx, "private_player", false)
+
+			profiles, err := repo.GetPublicProfiles(ctx, []int64{private, -1, public})
+			require.NoError(t, err)
+			require.Len(t, profiles, 2)
+
+			assert.Equal(t, private, profiles[0].UserID)
+			assert.Equal(t, "private_player", profiles[0].PublicName.Value())
+			assert.Nil(t, profiles[0].Bio)
+
+			assert.Equal(t, public, profiles[1].UserID)
+			assert.NotNil(t, profiles[1].Bio)
+		})
+	})
+
+	t.Run("should skip deleted and banned users but not suspended ones", func(t *testing.T) {
+		withRollback(t, db, func(ctx context.Context) {
+			deleted := createProfile(t, ctx, "gone_player", true)
+			banned := createProfile(t, ctx, "banned_player", true)
+			suspended := createProfile(t, ctx, "suspended_player", true)
+
+			_, err := db.Querier(ctx).Exec(ctx, `
+				UPDATE users SET status = CASE id WHEN $1 THEN 'deleted' WHEN $2 THEN 'banned' ELSE 'suspended' END
+				WHERE id IN ($1, $2, $3)
+			`, deleted, banned, suspended)
+			require.NoError(t, err)
+
+			profiles, err := repo.GetPublicProfiles(ctx, []int64{deleted, banned, suspended})
+			require.NoError(t, err)
+			require.Len(t, profiles, 1)
+			assert.Equal(t, suspended, profiles[0].UserID)
+		})
+	})
+}
@@ -0,0 +1,575 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/postgres"
+	postgreserrors "github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/postgres/errors"
+)
+
+const ratingColumns = `
+	id, user_id, time_control, rating, deviation, volatility, peak_rating, lowest_rating,
+	games_played, wins, losses, draws, last_game_at, created_at, updated_at
+`
+
+const ratingHistoryColumns = `
+	id, user_id, time_control, old_rating, new_rating, rating_range, game_id, change_reason, actor_id, note, created_at
+`
+
+// unrankedUsers selects the users left out of leaderboards and ranks whatever their rating.
+const unrankedUsers = `(SELECT id FROM users WHERE status IN ('deleted', 'banned'))`
+
+// PostgresRatingRepo marks the ratings it returns as provisional following placement and leaves provisional
+// ratings, and those of deleted and banned users, out of leaderboards and ranks.
+type PostgresRatingRepo struct {
+	database  *postgres.Database
+	placement *user.Placement
+}
+
+var _ user.RatingRepository = new(PostgresRatingRepo)
+
+func NewPostgresRatingRepository(db *postgres.Database, placement *user.Placement) *PostgresRatingRepo {
+	return &PostgresRatingRepo{
+		database:  db,
+		placement: placement,
+	}
+}
+
+func (r *PostgresRatingRepo) GetUserRatings(ctx context.Context, userID int64) ([]*user.Rating, error) {
+	query := `SELECT ` + ratingColumns + ` FROM ratings WHERE user_id = $1 ORDER BY time_control`
+
+	rows, err := r.database.Querier(ctx).Query(ctx, query, userID)
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresRatingRepo.GetUserRatings query", err, nil)
+	}
+
+	ratings, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*user.Rating, error) {
+		return scanRating(row)
+	})
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresRatingRepo.GetUserRatings scan", err, nil)
+	}
+
+	r.placement.Mark(ratings...)
+
+	return ratings, nil
+}
+
+func (r *PostgresRatingRepo) GetUserRating(
+	ctx context.Context,
+	userID int64,
+	timeControl enums.TimeControl,
+) (*user.Rating, error) {
+	query := `SELECT ` + ratingColumns + ` FROM ratings WHERE user_id = $1 AND time_control = $2`
+
+	row := r.database.Querier(ctx).QueryRow(ctx, query, userID, timeControl)
+
+	rating, err := scanRating(row)
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresRatingRepo.GetUserRating", err, mapRatingNotFound)
+	}
+
+	r.placement.Mark(rating)
+
+	return rating, nil
+}
+
+func (r *PostgresRatingRepo) LockUserRating(
+	ctx context.Context,
+	userID int64,
+	timeControl enums.TimeControl,
+) (*user.Rating, error) {
+	initial := &user.Rating{}
+	if err := initial.Initialize(userID, timeControl); err != nil {
+		return nil, err
+	}
+
+	insert := `
+		INSERT INTO ratings (
+			id, user_id, time_control, rating, deviation, volatility, peak_rating, lowest_rating
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8
+		)
+		ON CONFLICT (user_id, time_control) DO NOTHING
+	`
+
+	_, err := r.database.Querier(ctx).Exec(ctx, insert,
+		initial.Id,
+		initial.UserID,
+		initial.TimeControl,
+		initial.Rating,
+		initial.Deviation,
+		initial.Volatility,
+		initial.PeakRating,
+		initial.LowestRating,
+	)
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresRatingRepo.LockUserRating insert", err, mapUserForeignKey)
+	}
+
+	query := `SELECT ` + ratingColumns + ` FROM ratings WHERE user_id = $1 AND time_control = $2 FOR UPDATE`
+
+	row := r.database.Querier(ctx).QueryRow(ctx, query, userID, timeControl)
+
+	rating, err := scanRating(row)
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresRatingRepo.LockUserRating select", err, mapRatingNotFound)
+	}
+
+	r.placement.Mark(rating)
+
+	return rating, nil
+}
+
+func (r *PostgresRatingRepo) GetRatingsByUserIDs(
+	ctx context.Context,
+	timeControl enums.TimeControl,
+	userIDs []int64,
+) ([]*user.Rating, error) {
+	query := `SELECT ` + ratingColumns + ` FROM ratings WHERE time_control = $1 AND user_id = ANY($2)`
+
+	rows, err := r.database.Querier(ctx).Query(ctx, query, timeControl, userIDs)
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresRatingRepo.GetRatingsByUserIDs query", err, nil)
+	}
+
+	ratings, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*user.Rating, error) {
+		return scanRating(row)
+	})
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresRatingRepo.GetRatingsByUserIDs scan", err, nil)
+	}
+
+	r.placement.Mark(ratings...)
+
+	return ratings, nil
+}
+
+func (r *PostgresRatingRepo) ListRatings(
+	ctx context.Context,
+	timeControl enums.TimeControl,
+	afterUserID int64,
+	limit int,
+) ([]*user.Rating, error) {
+	query := `
+		SELECT ` + ratingColumns + `
+		FROM ratings
+		WHERE time_control = $1 AND user_id > $2 AND user_id NOT IN ` + unrankedUsers + `
+		ORDER BY user_id
+		LIMIT $3
+	`
+
+	rows, err := r.database.Querier(ctx).Query(ctx, query, timeControl, afterUserID, limit)
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresRatingRepo.ListRatings query", err, nil)
+	}
+
+	ratings, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*user.Rating, error) {
+		return scanRating(row)
+	})
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresRatingRepo.ListRatings scan", err, nil)
+	}
+
+	r.placement.Mark(ratings...)
+
+	return ratings, nil
+}
+
+// UpdateRating stamps updated_at with clock_timestamp() rather than the transaction start time: the row is
+// locked by then, so updates of one rating always get increasing timestamps that can version copies of it.
+func (r *PostgresRatingRepo) UpdateRating(ctx context.Context, rating *user.Rating) error {
+	query := `
+		UPDATE ratings SET
+			rating = $1,
+			deviation = $2,
+			volatility = $3,
+			peak_rating = $4,
+			lowest_rating = $5,
+			games_played = $6,
+			wins = $7,
+			losses = $8,
+			draws = $9,
+			last_game_at = $10,
+			updated_at = clock_timestamp()
+		WHERE id = $11
+		RETURNING updated_at
+	`
+
+	err := r.database.Querier(ctx).QueryRow(ctx, query,
+		rating.Rating,
+		rating.Deviation,
+		rating.Volatility,
+		rating.PeakRating,
+		rating.LowestRating,
+		rating.GamesPlayed,
+		rating.Wins,
+		rating.Losses,
+		rating.Draws,
+		rating.LastGameAt,
+		rating.Id,
+	).Scan(&rating.UpdatedAt)
+	if err != nil {
+		return postgreserrors.WrapWithMapper("PostgresRatingRepo.UpdateRating", err, mapRatingNotFound)
+	}
+
+	return nil
+}
+
+func (r *PostgresRatingRepo) CreateRatingHistory(ctx context.Context, history *user.RatingHistory) error {
+	query := `
+		INSERT INTO rating_history (
+			id, user_id, time_control, old_rating, new_rating, rating_range, game_id, change_reason, actor_id, note,
+			created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+		)
+	`
+
+	_, err := r.database.Querier(ctx).Exec(ctx, query,
+		history.Id,
+		history.UserID,
+		history.TimeControl,
+		history.OldRating,
+		history.NewRating,
+		history.RatingRange,
+		history.GameID,
+		history.ChangeReason,
+		history.ActorID,
+		history.Note,
+		history.CreatedAt,
+	)
+	if err != nil {
+		return postgreserrors.WrapWithMapper("PostgresRatingRepo.CreateRatingHistory", err, func(e error) error {
+			if _, ok := postgreserrors.UniqueViolation(e); ok {
+				return domainerrors.ErrGameAlreadyRecorded
+			}
+
+			return mapUserForeignKey(e)
+		})
+	}
+
+	return nil
+}
+
+func (r *PostgresRatingRepo) HasGameHistory(ctx context.Context, gameID uuid.UUID) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM rating_history WHERE game_id = $1 AND change_reason = 'game')`
+
+	var exists bool
+
+	if err := r.database.Querier(ctx).QueryRow(ctx, query, gameID).Scan(&exists); err != nil {
+		return false, postgreserrors.WrapWithMapper("PostgresRatingRepo.HasGameHistory", err, nil)
+	}
+
+	return exists, nil
+}
+
+func (r *PostgresRatingRepo) GetGameHistory(ctx context.Context, gameID uuid.UUID) ([]*user.RatingHistory, error) {
+	query := `SELECT ` + ratingHistoryColumns + ` FROM rating_history WHERE game_id = $1 ORDER BY created_at, id`
+
+	rows, err := r.database.Querier(ctx).Query(ctx, query, gameID)
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresRatingRepo.GetGameHistory query", err, nil)
+	}
+
+	history, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*user.RatingHistory, error) {
+		return scanRatingHistory(row)
+	})
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresRatingRepo.GetGameHistory scan", err, nil)
+	}
+
+	return history, nil
+}
+
+func (r *PostgresRatingRepo) GetRatingHistory(
+	ctx context.Context,
+	userID int64,
+	timeControl enums.TimeControl,
+	limit int,
+) ([]*user.RatingHistory, error) {
+	query := `
+		SELECT ` + ratingHistoryColumns + `
+		FROM rating_history
+		WHERE user_id = $1 AND time_control = $2
+		ORDER BY created_at DESC
+		LIMIT $3
+	`
+
+	rows, err := r.database.Querier(ctx).Query(ctx, query, userID, timeControl, limit)
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresRatingRepo.GetRatingHistory query", err, nil)
+	}
+
+	history, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*user.RatingHistory, error) {
+		return scanRatingHistory(row)
+	})
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresRatingRepo.GetRatingHistory scan", err, nil)
+	}
+
+	return history, nil
+}
+
+func (r *PostgresRatingRepo) ListRatingHistory(
+	ctx context.Context,
+	userID int64,
+	after *user.RatingHistoryCursor,
+	limit int,
+) ([]*user.RatingHistory, error) {
+	query := `
+		SELECT ` + ratingHistoryColumns + `
+		FROM rating_history
+		WHERE user_id = $1
+	`
+	args := []any{userID, limit}
+
+	if after != nil {
+		query += ` AND (created_at, id) > ($3, $4)`
+		args = append(args, after.CreatedAt, after.ID)
+	}
+
+	query += ` ORDER BY created_at, id LIMIT $2`
+
+	rows, err := r.database.Querier(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresRatingRepo.ListRatingHistory query", err, nil)
+	}
+
+	history, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*user.RatingHistory, error) {
+		return scanRatingHistory(row)
+	})
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresRatingRepo.ListRatingHistory scan", err, nil)
+	}
+
+	return history, nil
+}
+
+// GetRatingBuckets takes open and close from the first and last change of each bucket, min and max over the
+// ratings before and after every change.
+func (r *PostgresRatingRepo) GetRatingBuckets(
+	ctx context.Context,
+	userID int64,
+	timeControl enums.TimeControl,
+	interval enums.ChartInterval,
+	from, to time.Time,
+) ([]*user.RatingBucket, error) {
+	query := `
+		SELECT
+			date_trunc($3::text, created_at AT TIME ZONE 'UTC') AS bucket,
+			(array_agg(old_rating ORDER BY created_at, id))[1],
+			(array_agg(new_rating ORDER BY created_at DESC, id DESC))[1],
+			MIN(LEAST(old_rating, new_rating)),
+			MAX(GREATEST(old_rating, new_rating)),
+			COUNT(*) FILTER (WHERE game_id IS NOT NULL)
+		FROM rating_history
+		WHERE user_id = $1 AND time_control = $2 AND created_at >= $4 AND created_at < $5
+		GROUP BY bucket
+		ORDER BY bucket
+	`
+
+	rows, err := r.database.Querier(ctx).Query(ctx, query, userID, timeControl, interval, from, to)
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresRatingRepo.GetRatingBuckets query", err, nil)
+	}
+
+	buckets, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*user.RatingBucket, error) {
+		var b user.RatingBucket
+
+		err := row.Scan(&b.Start, &b.Open, &b.Close, &b.Min, &b.Max, &b.Games)
+
+		return &b, err
+	})
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresRatingRepo.GetRatingBuckets scan", err, nil)
+	}
+
+	return buckets, nil
+}
+
+func (r *PostgresRatingRepo) GetRecentRatingBuckets(
+	ctx context.Context,
+	userID int64,
+	timeControl enums.TimeControl,
+	days int,
+) ([]*user.RatingBucket, error) {
+	now := time.Now().UTC()
+	from := now.Truncate(24*time.Hour).AddDate(0, 0, 1-days)
+
+	return r.GetRatingBuckets(ctx, userID, timeControl, enums.ChartIntervalDay, from, now)
+}
+
+// GetLeaderboard pages with a keyset on (rating desc, user_id) so deep pages cost the same as the first one.
+func (r *PostgresRatingRepo) GetLeaderboard(
+	ctx context.Context,
+	timeControl enums.TimeControl,
+	limit int,
+	after *user.LeaderboardCursor,
+) ([]*user.LeaderboardEntry, error) {
+	query := `
+		SELECT ` + ratingColumns + `
+		FROM ratings
+		WHERE time_control = $1 AND games_played >= $3 AND user_id NOT IN ` + unrankedUsers + `
+	`
+	args := []any{timeControl, limit, r.placement.Games(timeControl)}
+
+	if after != nil {
+		query += ` AND (rating < $4 OR (rating = $4 AND user_id > $5))`
+		args = append(args, after.Rating, after.UserID)
+	}
+
+	query += ` ORDER BY rating DESC, user_id LIMIT $2`
+
+	rows, err := r.database.Querier(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresRatingRepo.GetLeaderboard query", err, nil)
+	}
+
+	ratings, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*user.Rating, error) {
+		return scanRating(row)
+	})
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresRatingRepo.GetLeaderboard scan", err, nil)
+	}
+
+	if len(ratings) == 0 {
+		return []*user.LeaderboardEntry{}, nil
+	}
+
+	r.placement.Mark(ratings...)
+
+	first := ratings[0]
+
+	// above: players strictly better than the first row, before: rows preceding it in leaderboard order.
+	countQuery := `
+		SELECT
+			COUNT(*) FILTER (WHERE rating > $2),
+			COUNT(*) FILTER (WHERE rating > $2 OR user_id < $3)
+		FROM ratings
+		WHERE time_control = $1 AND rating >= $2 AND games_played >= $4 AND user_id NOT IN ` + unrankedUsers + `
+	`
+
+	var above, before int
+
+	err = r.database.Querier(ctx).QueryRow(ctx, countQuery,
+		timeControl, first.Rating, first.UserID, r.placement.Games(timeControl),
+	).Scan(&above, &before)
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresRatingRepo.GetLeaderboard count", err, nil)
+	}
+
+	return user.RankLeaderboard(ratings, above, before), nil
+}
+
+// GetUserRank uses competition ranking: players with equal ratings share a rank. Provisional ratings have no
+// rank and are reported as not found.
+func (r *PostgresRatingRepo) GetUserRank(ctx context.Context, userID int64, timeControl enums.TimeControl) (int, error) {
+	query := `
+		SELECT (
+			SELECT COUNT(*) + 1
+			FROM ratings r
+			WHERE r.time_control = own.time_control AND r.rating > own.rating AND r.games_played >= $3
+				AND r.user_id NOT IN ` + unrankedUsers + `
+		)
+		FROM ratings own
+		WHERE own.user_id = $1 AND own.time_control = $2 AND own.games_played >= $3
+			AND own.user_id NOT IN ` + unrankedUsers + `
+	`
+
+	var rank int
+
+	err := r.database.Querier(ctx).QueryRow(ctx, query, userID, timeControl, r.placement.Games(timeControl)).Scan(&rank)
+	if err != nil {
+		return 0, postgreserrors.WrapWithMapper("PostgresRatingRepo.GetUserRank", err, mapRatingNotFound)
+	}
+
+	return rank, nil
+}
+
+func mapRatingNotFound(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domainerrors.ErrRatingNotFound
+	}
+
+	return fmt.Errorf("scan error: %w", err)
+}
+
+func mapUserForeignKey(err error) error {
+	if _, ok := postgreserrors.ForeignKeyViolation(err); ok {
+		return domainerrors.ErrUserNotFound
+	}
+
+	return err
+}
+
+func scanRating(row pgx.Row) (*user.Rating, error) {
+	var (
+		r           user.Rating
+		timeControl string
+	)
+
+	err := row.Scan(
+		&r.Id,
+		&r.UserID,
+		&timeControl,
+		&r.Rating,
+		&r.Deviation,
+		&r.Volatility,
+		&r.PeakRating,
+		&r.LowestRating,
+		&r.GamesPlayed,
+		&r.Wins,
+		&r.Losses,
+		&r.Draws,
+		&r.LastGameAt,
+		&r.CreatedAt,
+		&r.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.TimeControl = enums.TimeControl(timeControl)
+
+	return &r, nil
+}
+
+func scanRatingHistory(row pgx.Row) (*user.RatingHistory, error) {
+	var (
+		h            user.RatingHistory
+		timeControl  string
+		changeReason string
+	)
+
+	err := row.Scan(
+		&h.Id,
+		&h.UserID,
+		&timeControl,
+		&h.OldRating,
+		&h.NewRating,
+		&h.RatingRange,
+		&h.GameID,
+		&changeReason,
+		&h.ActorID,
+		&h.Note,
+		&h.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	h.TimeControl = enums.TimeControl(timeControl)
+	h.ChangeReason = enums.ChangeReason(changeReason)
+
+	return &h, nil
+}
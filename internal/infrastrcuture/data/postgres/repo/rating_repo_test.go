@@ -0,0 +1,362 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+)
+
+func TestPostgresRatingRepo(t *testing.T) {
+	db := openTestDatabase(t)
+	repo := NewPostgresRatingRepository(db, nil)
+
+	tables := []string{"rating_history", "ratings"}
+
+	createUser := func(t *testing.T, ctx context.Context) int64 {
+		t.Helper()
+
+		return createTestUser(t, ctx, db)
+	}
+
+	t.Run("should create default rating once when locking", func(t *testing.T) {
+		withEmptyTables(t, db, tables, func(ctx context.Context) {
+			userID := createUser(t, ctx)
+
+			first, err := repo.LockUserRating(ctx, userID, enums.TimeControlBlitz)
+			require.NoError(t, err)
+			assert.Equal(t, 1200, first.Rating)
+
+			second, err := repo.LockUserRating(ctx, userID, enums.TimeControlBlitz)
+			require.NoError(t, err)
+			assert.Equal(t, first.Id, second.Id)
+		})
+	})
+
+	t.Run("should reject a second history entry for the same game", func(t *testing.T) {
+		withEmptyTables(t, db, tables, func(ctx context.Context) {
+			userID := createUser(t, ctx)
+			gameID := uuid.New()
+
+			history := func() *user.RatingHistory {
+				return &user.RatingHistory{
+					Id:           uuid.New(),
+					UserID:       userID,
+					TimeControl:  enums.TimeControlBlitz,
+					OldRating:    1200,
+					NewRating:    1210,
+					GameID:       &gameID,
+					ChangeReason: enums.ChangeReasonGame,
+				}
+			}
+
+			require.NoError(t, repo.CreateRatingHistory(ctx, history()))
+
+			recorded, err := repo.HasGameHistory(ctx, gameID)
+			require.NoError(t, err)
+			assert.True(t, recorded)
+
+			// A failed statement aborts the transaction, so the duplicate insert runs in a savepoint.
+			err = db.WithinTx(ctx, func(ctx context.Context) error {
+				_, err := db.Querier(ctx).Exec(ctx, `SAVEPOINT duplicate`)
+				require.NoError(t, err)
+
+				err = repo.CreateRatingHistory(ctx, history())
+
+				_, rollbackErr := db.Querier(ctx).Exec(ctx, `ROLLBACK TO SAVEPOINT duplicate`)
+				require.NoError(t, rollbackErr)
+
+				return err
+			})
+			assert.ErrorIs(t, err, domainerrors.ErrGameAlreadyRecorded)
+		})
+	})
+
+	t.Run("should page leaderboard with cursor and competition ranks", func(t *testing.T) {
+		withEmptyTables(t, db, tables, func(ctx context.Context) {
+			values := []int{2100, 1900, 1900, 1900, 1500}
+			ids := make([]int64, 0, len(values))
+
+			for _, v := range values {
+				id := createUser(t, ctx)
+				setTestRating(t, ctx, repo, id, v, 0)
+				ids = append(ids, id)
+			}
+
+			var (
+				after   *user.LeaderboardCursor
+				got     []string
+				userIDs []int64
+			)
+
+			for {
+				page, err := repo.GetLeaderboard(ctx, enums.TimeControlBlitz, 2, after)
+				require.NoError(t, err)
+
+				if len(page) == 0 {
+					break
+				}
+
+				for _, e := range page {
+					got = append(got, fmt.Sprintf("%d:%d", e.Rank, e.Rating.Rating))
+					userIDs = append(userIDs, e.Rating.UserID)
+				}
+
+				after = page[len(page)-1].Cursor()
+			}
+
+			assert.Equal(t, []string{"1:2100", "2:1900", "2:1900", "2:1900", "5:1500"}, got)
+			assert.Equal(t, ids, userIDs)
+		})
+	})
+
+	t.Run("should return user rank sharing ties", func(t *testing.T) {
+		withEmptyTables(t, db, tables, func(ctx context.Context) {
+			top, tied, other := createUser(t, ctx), createUser(t, ctx), createUser(t, ctx)
+			setTestRating(t, ctx, repo, top, 2000, 0)
+			setTestRating(t, ctx, repo, tied, 1800, 0)
+			setTestRating(t, ctx, repo, other, 1800, 0)
+
+			rank, err := repo.GetUserRank(ctx, other, enums.TimeControlBlitz)
+			require.NoError(t, err)
+			assert.Equal(t, 2, rank)
+
+			_, err = repo.GetUserRank(ctx, top, enums.TimeControlBullet)
+			assert.ErrorIs(t, err, domainerrors.ErrRatingNotFound)
+		})
+	})
+
+	t.Run("should mark provisional ratings and leave them unranked", func(t *testing.T) {
+		withEmptyTables(t, db, tables, func(ctx context.Context) {
+			placed := NewPostgresRatingRepository(db, user.NewPlacement(map[enums.TimeControl]int{enums.TimeControlBlitz: 5}, 0.09))
+
+			established, provisional := createUser(t, ctx), createUser(t, ctx)
+
+			for userID, games := range map[int64]int{established: 5, provisional: 4} {
+				r, err := placed.LockUserRating(ctx, userID, enums.TimeControlBlitz)
+				require.NoError(t, err)
+				assert.True(t, r.Provisional)
+
+				r.Rating, r.GamesPlayed = 1500+games, games
+				require.NoError(t, placed.UpdateRating(ctx, r))
+			}
+
+			ratings, err := placed.GetRatingsByUserIDs(ctx, enums.TimeControlBlitz, []int64{established, provisional})
+			require.NoError(t, err)
+			require.Len(t, ratings, 2)
+
+			for _, r := range ratings {
+				assert.Equal(t, r.UserID == provisional, r.Provisional)
+			}
+
+			rank, err := placed.GetUserRank(ctx, established, enums.TimeControlBlitz)
+			require.NoError(t, err)
+			assert.Equal(t, 1, rank)
+
+			_, err = placed.GetUserRank(ctx, provisional, enums.TimeControlBlitz)
+			require.ErrorIs(t, err, domainerrors.ErrRatingNotFound)
+
+			entries, err := placed.GetLeaderboard(ctx, enums.TimeControlBlitz, 10, nil)
+			require.NoError(t, err)
+			require.Len(t, entries, 1)
+			assert.Equal(t, established, entries[0].Rating.UserID)
+		})
+	})
+
+	t.Run("should leave deleted and banned users out of leaderboards and ranks", func(t *testing.T) {
+		withEmptyTables(t, db, tables, func(ctx context.Context) {
+			active, deleted, banned := createUser(t, ctx), createUser(t, ctx), createUser(t, ctx)
+			setTestRating(t, ctx, repo, active, 1500, 0)
+			setTestRating(t, ctx, repo, deleted, 1900, 0)
+			setTestRating(t, ctx, repo, banned, 2100, 0)
+
+			_, err := db.Querier(ctx).Exec(ctx, `UPDATE users SET status = 'deleted' WHERE id = $1`, deleted)
+			require.NoError(t, err)
+
+			_, err = db.Querier(ctx).Exec(ctx, `UPDATE users SET status = 'banned' WHERE id = $1`, banned)
+			require.NoError(t, err)
+
+			entries, err := repo.GetLeaderboard(ctx, enums.TimeControlBlitz, 10, nil)
+			require.NoError(t, err)
+			require.Len(t, entries, 1)
+			assert.Equal(t, active, entries[0].Rating.UserID)
+			assert.Equal(t, 1, entries[0].Rank)
+
+			rank, err := repo.GetUserRank(ctx, active, enums.TimeControlBlitz)
+			require.NoError(t, err)
+			assert.Equal(t, 1, rank)
+
+			_, err = repo.GetUserRank(ctx, banned, enums.TimeControlBlitz)
+			assert.ErrorIs(t, err, domainerrors.ErrRatingNotFound)
+		})
+	})
+
+	t.Run("should list ratings for a leaderboard rebuild without deleted and banned users", func(t *testing.T) {
+		withEmptyTables(t, db, tables, func(ctx context.Context) {
+			active, deleted, banned := createUser(t, ctx), createUser(t, ctx), createUser(t, ctx)
+
+			for _, userID := range []int64{active, deleted, banned} {
+				setTestRating(t, ctx, repo, userID, 1500, 0)
+			}
+
+			_, err := db.Querier(ctx).Exec(ctx, `UPDATE users SET status = 'deleted' WHERE id = $1`, deleted)
+			require.NoError(t, err)
+
+			_, err = db.Querier(ctx).Exec(ctx, `UPDATE users SET status = 'banned' WHERE id = $1`, banned)
+			require.NoError(t, err)
+
+			ratings, err := repo.ListRatings(ctx, enums.TimeControlBlitz, 0, 10)
+			require.NoError(t, err)
+			require.Len(t, ratings, 1)
+			assert.Equal(t, active, ratings[0].UserID)
+		})
+	})
+
+	t.Run("should page the whole history across time controls", func(t *testing.T) {
+		withEmptyTables(t, db, tables, func(ctx context.Context) {
+			userID := createUser(t, ctx)
+
+			for i, timeControl := range []enums.TimeControl{enums.TimeControlBlitz, enums.TimeControlRapid, enums.TimeControlBlitz} {
+				require.NoError(t, repo.CreateRatingHistory(ctx, &user.RatingHistory{
+					Id:           uuid.New(),
+					UserID:       userID,
+					TimeControl:  timeControl,
+					OldRating:    1200 + i,
+					NewRating:    1201 + i,
+					ChangeReason: enums.ChangeReasonAdjustment,
+				}))
+			}
+
+			var (
+				seen  []uuid.UUID
+				after *user.RatingHistoryCursor
+			)
+
+			for {
+				page, err := repo.ListRatingHistory(ctx, userID, after, 2)
+				require.NoError(t, err)
+
+				for _, h := range page {
+					seen = append(seen, h.Id)
+				}
+
+				if len(page) < 2 {
+					break
+				}
+
+				last := page[len(page)-1]
+				after = &user.RatingHistoryCursor{CreatedAt: last.CreatedAt, ID: last.Id}
+			}
+
+			unique := make(map[uuid.UUID]struct{}, len(seen))
+			for _, id := range seen {
+				unique[id] = struct{}{}
+			}
+
+			assert.Len(t, seen, 3)
+			assert.Len(t, unique, 3)
+		})
+	})
+
+	t.Run("should aggregate history into daily and monthly buckets", func(t *testing.T) {
+		withEmptyTables(t, db, tables, func(ctx context.Context) {
+			userID := createUser(t, ctx)
+			day := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+			gameID := uuid.New()
+
+			changes := []struct {
+				at       time.Time
+				old, new int
+				gameID   *uuid.UUID
+			}{
+				{day.Add(time.Hour), 1500, 1520, &gameID},
+				{day.Add(2 * time.Hour), 1520, 1490, nil},
+				{day.Add(3 * time.Hour), 1490, 1505, nil},
+				{day.AddDate(0, 0, 1), 1505, 1510, nil},
+			}
+
+			for _, c := range changes {
+				require.NoError(t, repo.CreateRatingHistory(ctx, &user.RatingHistory{
+					Id:           uuid.New(),
+					UserID:       userID,
+					TimeControl:  enums.TimeControlBlitz,
+					OldRating:    c.old,
+					NewRating:    c.new,
+					GameID:       c.gameID,
+					ChangeReason: enums.ChangeReasonAdjustment,
+					CreatedAt:    c.at,
+				}))
+			}
+
+			daily, err := repo.GetRatingBuckets(ctx, userID, enums.TimeControlBlitz, enums.ChartIntervalDay,
+				day, day.AddDate(0, 0, 7))
+			require.NoError(t, err)
+			require.Len(t, daily, 2)
+
+			assert.True(t, day.Equal(daily[0].Start))
+			assert.Equal(t, user.RatingBucket{Start: daily[0].Start, Open: 1500, Close: 1505, Min: 1490, Max: 1520, Games: 1},
+				*daily[0])
+			assert.Equal(t, 1510, daily[1].Close)
+
+			monthly, err := repo.GetRatingBuckets(ctx, userID, enums.TimeControlBlitz, enums.ChartIntervalMonth,
+				day.AddDate(0, -1, 0), day.AddDate(0, 1, 0))
+			require.NoError(t, err)
+			require.Len(t, monthly, 1)
+
+			assert.True(t, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC).Equal(monthly[0].Start))
+			assert.Equal(t, 1500, monthly[0].Open)
+			assert.Equal(t, 1510, monthly[0].Close)
+		})
+	})
+
+	t.Run("should keep a refund next to the game it refunds", func(t *testing.T) {
+		withEmptyTables(t, db, tables, func(ctx context.Context) {
+			userID := createUser(t, ctx)
+			gameID := uuid.New()
+			actorID := int64(7)
+			playedAt := time.Now().Add(-time.Hour).Truncate(time.Microsecond)
+
+			require.NoError(t, repo.CreateRatingHistory(ctx, &user.RatingHistory{
+				Id:           uuid.New(),
+				UserID:       userID,
+				TimeControl:  enums.TimeControlBlitz,
+				OldRating:    1500,
+				NewRating:    1490,
+				GameID:       &gameID,
+				ChangeReason: enums.ChangeReasonGame,
+				CreatedAt:    playedAt,
+			}))
+
+			require.NoError(t, repo.CreateRatingHistory(ctx, &user.RatingHistory{
+				Id:           uuid.New(),
+				UserID:       userID,
+				TimeControl:  enums.TimeControlBlitz,
+				OldRating:    1490,
+				NewRating:    1500,
+				GameID:       &gameID,
+				ChangeReason: enums.ChangeReasonRefund,
+				ActorID:      &actorID,
+				Note:         "opponent banned for engine use",
+				CreatedAt:    playedAt.Add(time.Minute),
+			}))
+
+			entries, err := repo.GetGameHistory(ctx, gameID)
+			require.NoError(t, err)
+			require.Len(t, entries, 2)
+
+			assert.Equal(t, enums.ChangeReasonGame, entries[0].ChangeReason)
+			assert.Nil(t, entries[0].ActorID)
+			assert.Equal(t, enums.ChangeReasonRefund, entries[1].ChangeReason)
+			assert.Equal(t, actorID, *entries[1].ActorID)
+			assert.Equal(t, "opponent banned for engine use", entries[1].Note)
+		})
+	})
+}
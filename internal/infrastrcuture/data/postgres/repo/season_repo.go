@@ -0,0 +1,306 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/postgres"
+	postgreserrors "github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/postgres/errors"
+)
+
+const seasonStandingColumns = `
+	season_id, time_control, user_id, rank, rating, deviation, games_played, wins, losses, draws
+`
+
+const seasonResetColumns = `
+	r.season_id, s.name, r.time_control, r.mean_rating, r.last_user_id, r.completed_at
+`
+
+type PostgresSeasonRepo struct {
+	database  *postgres.Database
+	placement *user.Placement
+}
+
+var _ user.SeasonRepository = new(PostgresSeasonRepo)
+
+func NewPostgresSeasonRepository(db *postgres.Database, placement *user.Placement) *PostgresSeasonRepo {
+	return &PostgresSeasonRepo{
+		database:  db,
+		placement: placement,
+	}
+}
+
+func (r *PostgresSeasonRepo) Create(ctx context.Context, season *user.Season) error {
+	query := `
+		INSERT INTO seasons (name, started_at)
+		VALUES ($1, $2)
+		RETURNING id
+	`
+
+	err := r.database.Querier(ctx).QueryRow(ctx, query, season.Name, season.StartedAt).Scan(&season.ID)
+	if err != nil {
+		return postgreserrors.WrapWithMapper("PostgresSeasonRepo.Create", err, mapSeasonUniqueViolation)
+	}
+
+	return nil
+}
+
+func (r *PostgresSeasonRepo) GetByID(ctx context.Context, id int64) (*user.Season, error) {
+	query := `SELECT id, name, started_at, ended_at FROM seasons WHERE id = $1`
+
+	season, err := scanSeason(r.database.Querier(ctx).QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresSeasonRepo.GetByID", err, mapSeasonNotFound)
+	}
+
+	return season, nil
+}
+
+func (r *PostgresSeasonRepo) LockCurrent(ctx context.Context) (*user.Season, error) {
+	query := `SELECT id, name, started_at, ended_at FROM seasons WHERE ended_at IS NULL FOR UPDATE`
+
+	season, err := scanSeason(r.database.Querier(ctx).QueryRow(ctx, query))
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresSeasonRepo.LockCurrent", err, mapSeasonNotFound)
+	}
+
+	return season, nil
+}
+
+func (r *PostgresSeasonRepo) Update(ctx context.Context, season *user.Season) error {
+	query := `UPDATE seasons SET name = $2, ended_at = $3 WHERE id = $1`
+
+	tag, err := r.database.Querier(ctx).Exec(ctx, query, season.ID, season.Name, season.EndedAt)
+	if err != nil {
+		return postgreserrors.WrapWithMapper("PostgresSeasonRepo.Update", err, nil)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return postgreserrors.WrapWithMapper("PostgresSeasonRepo.Update", pgx.ErrNoRows, mapSeasonNotFound)
+	}
+
+	return nil
+}
+
+// ArchiveStandings ranks players the way the leaderboard does: equal ratings share a rank.
+func (r *PostgresSeasonRepo) ArchiveStandings(
+	ctx context.Context,
+	seasonID int64,
+	timeControl enums.TimeControl,
+) (int, float64, error) {
+	query := `
+		WITH archived AS (
+			INSERT INTO season_standings (` + seasonStandingColumns + `)
+			SELECT $1, r.time_control, r.user_id, RANK() OVER (ORDER BY r.rating DESC), r.rating, r.deviation,
+				r.games_played, r.wins, r.losses, r.draws
+			FROM ratings r
+			WHERE r.time_control = $2 AND r.games_played >= GREATEST($3::int, 1)
+				AND r.user_id NOT IN ` + unrankedUsers + `
+			RETURNING rating
+		)
+		SELECT COUNT(*), COALESCE(AVG(rating), 0) FROM archived
+	`
+
+	var (
+		players int
+		mean    float64
+	)
+
+	err := r.database.Querier(ctx).QueryRow(ctx, query, seasonID, timeControl, r.placement.Games(timeControl)).
+		Scan(&players, &mean)
+	if err != nil {
+		return 0, 0, postgreserrors.WrapWithMapper("PostgresSeasonRepo.ArchiveStandings", err, nil)
+	}
+
+	return players, mean, nil
+}
+
+func (r *PostgresSeasonRepo) GetStandings(
+	ctx context.Context,
+	seasonID int64,
+	timeControl enums.TimeControl,
+	after *user.LeaderboardCursor,
+	limit int,
+) ([]*user.SeasonStanding, error) {
+	query := `
+		SELECT ` + seasonStandingColumns + `
+		FROM season_standings
+		WHERE season_id = $1 AND time_control = $2
+	`
+	args := []any{seasonID, timeControl, limit}
+
+	if after != nil {
+		query += ` AND (rating < $4 OR (rating = $4 AND user_id > $5))`
+		args = append(args, after.Rating, after.UserID)
+	}
+
+	query += ` ORDER BY rating DESC, user_id LIMIT $3`
+
+	rows, err := r.database.Querier(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresSeasonRepo.GetStandings query", err, nil)
+	}
+
+	standings, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*user.SeasonStanding, error) {
+		var s user.SeasonStanding
+
+		err := row.Scan(
+			&s.SeasonID,
+			&s.TimeControl,
+			&s.UserID,
+			&s.Rank,
+			&s.Rating,
+			&s.Deviation,
+			&s.GamesPlayed,
+			&s.Wins,
+			&s.Losses,
+			&s.Draws,
+		)
+
+		return &s, err
+	})
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresSeasonRepo.GetStandings scan", err, nil)
+	}
+
+	return standings, nil
+}
+
+func (r *PostgresSeasonRepo) CreateReset(ctx context.Context, reset *user.SeasonReset) error {
+	query := `
+		INSERT INTO season_resets (season_id, time_control, mean_rating, last_user_id, completed_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	_, err := r.database.Querier(ctx).Exec(ctx, query,
+		reset.SeasonID,
+		reset.TimeControl,
+		reset.MeanRating,
+		reset.LastUserID,
+		reset.CompletedAt,
+	)
+	if err != nil {
+		return postgreserrors.WrapWithMapper("PostgresSeasonRepo.CreateReset", err, nil)
+	}
+
+	return nil
+}
+
+func (r *PostgresSeasonRepo) ListPendingResets(ctx context.Context) ([]*user.SeasonReset, error) {
+	query := `
+		SELECT ` + seasonResetColumns + `
+		FROM season_resets r
+		JOIN seasons s ON s.id = r.season_id
+		WHERE r.completed_at IS NULL
+		ORDER BY r.season_id, r.time_control
+	`
+
+	rows, err := r.database.Querier(ctx).Query(ctx, query)
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresSeasonRepo.ListPendingResets query", err, nil)
+	}
+
+	resets, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*user.SeasonReset, error) {
+		return scanSeasonReset(row)
+	})
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresSeasonRepo.ListPendingResets scan", err, nil)
+	}
+
+	return resets, nil
+}
+
+func (r *PostgresSeasonRepo) LockReset(
+	ctx context.Context,
+	seasonID int64,
+	timeControl enums.TimeControl,
+) (*user.SeasonReset, error) {
+	query := `
+		SELECT ` + seasonResetColumns + `
+		FROM season_resets r
+		JOIN seasons s ON s.id = r.season_id
+		WHERE r.season_id = $1 AND r.time_control = $2
+		FOR UPDATE OF r
+	`
+
+	reset, err := scanSeasonReset(r.database.Querier(ctx).QueryRow(ctx, query, seasonID, timeControl))
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresSeasonRepo.LockReset", err, mapSeasonNotFound)
+	}
+
+	return reset, nil
+}
+
+func (r *PostgresSeasonRepo) UpdateReset(ctx context.Context, reset *user.SeasonReset) error {
+	query := `
+		UPDATE season_resets SET last_user_id = $3, completed_at = $4
+		WHERE season_id = $1 AND time_control = $2
+	`
+
+	tag, err := r.database.Querier(ctx).Exec(ctx, query,
+		reset.SeasonID,
+		reset.TimeControl,
+		reset.LastUserID,
+		reset.CompletedAt,
+	)
+	if err != nil {
+		return postgreserrors.WrapWithMapper("PostgresSeasonRepo.UpdateReset", err, nil)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return postgreserrors.WrapWithMapper("PostgresSeasonRepo.UpdateReset", pgx.ErrNoRows, mapSeasonNotFound)
+	}
+
+	return nil
+}
+
+func scanSeason(row pgx.Row) (*user.Season, error) {
+	var s user.Season
+
+	if err := row.Scan(&s.ID, &s.Name, &s.StartedAt, &s.EndedAt); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+func scanSeasonReset(row pgx.Row) (*user.SeasonReset, error) {
+	var reset user.SeasonReset
+
+	err := row.Scan(
+		&reset.SeasonID,
+		&reset.SeasonName,
+		&reset.TimeControl,
+		&reset.MeanRating,
+		&reset.LastUserID,
+		&reset.CompletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reset, nil
+}
+
+func mapSeasonNotFound(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domainerrors.ErrSeasonNotFound
+	}
+
+	return fmt.Errorf("scan error: %w", err)
+}
+
+// mapSeasonUniqueViolation reports a season started concurrently as the current one having ended already.
+func mapSeasonUniqueViolation(err error) error {
+	if constraint, ok := postgreserrors.UniqueViolation(err); ok && constraint == "seasons_current_uidx" {
+		return domainerrors.ErrSeasonEnded
+	}
+
+	return err
+}
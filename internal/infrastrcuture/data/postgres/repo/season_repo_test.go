@@ -0,0 +1,130 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+)
+
+func TestPostgresSeasonRepo(t *testing.T) {
+	db := openTestDatabase(t)
+	repo := NewPostgresSeasonRepository(db, nil)
+	ratingRepo := NewPostgresRatingRepository(db, nil)
+
+	tables := []string{"seasons", "rating_history", "ratings"}
+
+	t.Run("should keep a single season in progress", func(t *testing.T) {
+		withEmptyTables(t, db, tables, func(ctx context.Context) {
+			_, err := repo.LockCurrent(ctx)
+			require.ErrorIs(t, err, domainerrors.ErrSeasonNotFound)
+
+			first := &user.Season{Name: "first", StartedAt: time.Now()}
+			require.NoError(t, repo.Create(ctx, first))
+
+			current, err := repo.LockCurrent(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, first.ID, current.ID)
+
+			err = repo.Create(ctx, &user.Season{Name: "second", StartedAt: time.Now()})
+			require.ErrorIs(t, err, domainerrors.ErrSeasonEnded)
+		})
+	})
+
+	t.Run("should archive ranked standings of players with games", func(t *testing.T) {
+		withEmptyTables(t, db, tables, func(ctx context.Context) {
+			season := &user.Season{Name: "first", StartedAt: time.Now()}
+			require.NoError(t, repo.Create(ctx, season))
+
+			first, second, third, idle := createTestUser(t, ctx, db), createTestUser(t, ctx, db),
+				createTestUser(t, ctx, db), createTestUser(t, ctx, db)
+			setTestRating(t, ctx, ratingRepo, first, 1800, 10)
+			setTestRating(t, ctx, ratingRepo, second, 1600, 5)
+			setTestRating(t, ctx, ratingRepo, third, 1600, 3)
+			setTestRating(t, ctx, ratingRepo, idle, 1200, 0)
+
+			players, mean, err := repo.ArchiveStandings(ctx, season.ID, enums.TimeControlBlitz)
+			require.NoError(t, err)
+			assert.Equal(t, 3, players)
+			assert.InDelta(t, 1666.67, mean, 0.01)
+
+			standings, err := repo.GetStandings(ctx, season.ID, enums.TimeControlBlitz, nil, 2)
+			require.NoError(t, err)
+			require.Len(t, standings, 2)
+			assert.Equal(t, first, standings[0].UserID)
+			assert.Equal(t, 1, standings[0].Rank)
+			assert.Equal(t, 2, standings[1].Rank)
+
+			last := standings[1]
+			standings, err = repo.GetStandings(ctx, season.ID, enums.TimeControlBlitz,
+				&user.LeaderboardCursor{Rating: last.Rating, UserID: last.UserID}, 2)
+			require.NoError(t, err)
+			require.Len(t, standings, 1)
+			assert.Equal(t, 2, standings[0].Rank)
+		})
+	})
+
+	t.Run("should leave deleted and banned players out of the standings", func(t *testing.T) {
+		withEmptyTables(t, db, tables, func(ctx context.Context) {
+			season := &user.Season{Name: "first", StartedAt: time.Now()}
+			require.NoError(t, repo.Create(ctx, season))
+
+			active, deleted, banned := createTestUser(t, ctx, db), createTestUser(t, ctx, db), createTestUser(t, ctx, db)
+			setTestRating(t, ctx, ratingRepo, active, 1500, 10)
+			setTestRating(t, ctx, ratingRepo, deleted, 1900, 10)
+			setTestRating(t, ctx, ratingRepo, banned, 2100, 10)
+
+			_, err := db.Querier(ctx).Exec(ctx, `UPDATE users SET status = 'deleted' WHERE id = $1`, deleted)
+			require.NoError(t, err)
+
+			_, err = db.Querier(ctx).Exec(ctx, `UPDATE users SET status = 'banned' WHERE id = $1`, banned)
+			require.NoError(t, err)
+
+			players, _, err := repo.ArchiveStandings(ctx, season.ID, enums.TimeControlBlitz)
+			require.NoError(t, err)
+			assert.Equal(t, 1, players)
+
+			standings, err := repo.GetStandings(ctx, season.ID, enums.TimeControlBlitz, nil, 10)
+			require.NoError(t, err)
+			require.Len(t, standings, 1)
+			assert.Equal(t, active, standings[0].UserID)
+			assert.Equal(t, 1, standings[0].Rank)
+		})
+	})
+
+	t.Run("should track reset progress until completed", func(t *testing.T) {
+		withEmptyTables(t, db, tables, func(ctx context.Context) {
+			season := &user.Season{Name: "first", StartedAt: time.Now()}
+			require.NoError(t, repo.Create(ctx, season))
+
+			reset := &user.SeasonReset{SeasonID: season.ID, TimeControl: enums.TimeControlBlitz, MeanRating: 1500}
+			require.NoError(t, repo.CreateReset(ctx, reset))
+
+			pending, err := repo.ListPendingResets(ctx)
+			require.NoError(t, err)
+			require.Len(t, pending, 1)
+			assert.Equal(t, "first", pending[0].SeasonName)
+
+			locked, err := repo.LockReset(ctx, season.ID, enums.TimeControlBlitz)
+			require.NoError(t, err)
+
+			locked.Advance(42, true, time.Now())
+			require.NoError(t, repo.UpdateReset(ctx, locked))
+
+			pending, err = repo.ListPendingResets(ctx)
+			require.NoError(t, err)
+			assert.Empty(t, pending)
+
+			locked, err = repo.LockReset(ctx, season.ID, enums.TimeControlBlitz)
+			require.NoError(t, err)
+			assert.Equal(t, int64(42), locked.LastUserID)
+			assert.NotNil(t, locked.CompletedAt)
+		})
+	})
+}
@@ -0,0 +1,78 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/postgres"
+	postgreserrors "github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/postgres/errors"
+)
+
+type PostgresTagHistoryRepo struct {
+	database *postgres.Database
+}
+
+var _ user.TagHistoryRepository = new(PostgresTagHistoryRepo)
+
+func NewPostgresTagHistoryRepository(db *postgres.Database) *PostgresTagHistoryRepo {
+	return &PostgresTagHistoryRepo{
+		database: db,
+	}
+}
+
+func (r *PostgresTagHistoryRepo) Create(ctx context.Context, alias *user.TagAlias) error {
+	query := `
+		INSERT INTO tag_history (user_id, tag, expires_at)
+		VALUES ($1, $2, $3)
+		RETURNING id, released_at
+	`
+
+	err := r.database.Querier(ctx).QueryRow(ctx, query,
+		alias.UserID,
+		alias.Tag,
+		alias.ExpiresAt,
+	).Scan(&alias.ID, &alias.ReleasedAt)
+	if err != nil {
+		return postgreserrors.WrapWithMapper("PostgresTagHistoryRepo.Create", err, nil)
+	}
+
+	return nil
+}
+
+func (r *PostgresTagHistoryRepo) GetActive(ctx context.Context, tag string, at time.Time) (*user.TagAlias, error) {
+	query := `
+		SELECT id, user_id, tag, released_at, expires_at
+		FROM tag_history
+		WHERE tag = $1 AND expires_at > $2
+		ORDER BY expires_at DESC
+		LIMIT 1
+	`
+
+	var alias user.TagAlias
+
+	err := r.database.Querier(ctx).QueryRow(ctx, query, tag, at).Scan(
+		&alias.ID,
+		&alias.UserID,
+		&alias.Tag,
+		&alias.ReleasedAt,
+		&alias.ExpiresAt,
+	)
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresTagHistoryRepo.GetActive", err, mapUserNotFound)
+	}
+
+	return &alias, nil
+}
+
+func (r *PostgresTagHistoryRepo) LastReleasedAt(ctx context.Context, userID int64) (*time.Time, error) {
+	query := `SELECT MAX(released_at) FROM tag_history WHERE user_id = $1`
+
+	var releasedAt *time.Time
+
+	if err := r.database.Querier(ctx).QueryRow(ctx, query, userID).Scan(&releasedAt); err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresTagHistoryRepo.LastReleasedAt", err, nil)
+	}
+
+	return releasedAt, nil
+}
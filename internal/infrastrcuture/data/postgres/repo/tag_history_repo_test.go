@@ -0,0 +1,54 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+)
+
+func TestPostgresTagHistoryRepo(t *testing.T) {
+	db := openTestDatabase(t)
+	repo := NewPostgresTagHistoryRepository(db)
+
+	t.Run("should resolve a released tag until it expires", func(t *testing.T) {
+		withRollback(t, db, func(ctx context.Context) {
+			userID := createTestUser(t, ctx, db)
+			expiresAt := time.Now().Add(time.Hour)
+
+			alias := &user.TagAlias{UserID: userID, Tag: "old_tag", ExpiresAt: expiresAt}
+			require.NoError(t, repo.Create(ctx, alias))
+			assert.NotZero(t, alias.ID)
+
+			found, err := repo.GetActive(ctx, "old_tag", time.Now())
+			require.NoError(t, err)
+			assert.Equal(t, userID, found.UserID)
+
+			_, err = repo.GetActive(ctx, "old_tag", expiresAt.Add(time.Second))
+			require.ErrorIs(t, err, domainerrors.ErrUserNotFound)
+		})
+	})
+
+	t.Run("should report the last tag change of the user", func(t *testing.T) {
+		withRollback(t, db, func(ctx context.Context) {
+			userID := createTestUser(t, ctx, db)
+
+			releasedAt, err := repo.LastReleasedAt(ctx, userID)
+			require.NoError(t, err)
+			assert.Nil(t, releasedAt)
+
+			alias := &user.TagAlias{UserID: userID, Tag: "old_tag", ExpiresAt: time.Now().Add(time.Hour)}
+			require.NoError(t, repo.Create(ctx, alias))
+
+			releasedAt, err = repo.LastReleasedAt(ctx, userID)
+			require.NoError(t, err)
+			require.NotNil(t, releasedAt)
+			assert.True(t, alias.ReleasedAt.Equal(*releasedAt))
+		})
+	})
+}
@@ -9,22 +9,26 @@ import (
 	"github.com/jackc/pgx/v5"
 
 	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
 	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
-	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/interfaces"
 	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/email"
 	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/password"
-	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/publicname"
 	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/tag"
 	"github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/postgres"
 	postgreserrors "github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/postgres/errors"
 )
 
+const userColumns = `
+	id, tag, email, password, status, is_verified, is_premium, email_verified_at, premium_until,
+	suspended_until, deleted_at, anonymized_at, language, last_active_at, last_login_at, updated_at, created_at
+`
+
 type PostgresSessionRepo struct {
 	database     *postgres.Database
 	queryFactory postgres.UserQueryFactory
 }
 
-var _ interfaces.UserRepo = new(PostgresSessionRepo)
+var _ user.Repository = new(PostgresSessionRepo)
 
 func NewPostgresSessionRepository(db *postgres.Database, factory postgres.UserQueryFactory) *PostgresSessionRepo {
 	return &PostgresSessionRepo{
@@ -36,48 +40,85 @@ func NewPostgresSessionRepository(db *postgres.Database, factory postgres.UserQu
 func (r *PostgresSessionRepo) Create(ctx context.Context, u *user.User) (*user.User, error) {
 	query := `
 		INSERT INTO users (
-			email, public_name, tag, password, last_active_at
+			tag, email, password, status, is_verified, is_premium, email_verified_at, premium_until,
+			suspended_until, deleted_at, anonymized_at, language, last_active_at, last_login_at
 		) VALUES (
-			$1, $2, $3, $4, $5
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
 		)
-		RETURNING 
-			id, email, public_name, tag, password, last_active_at, updated_at, created_at
-	`
+		RETURNING ` + userColumns
 
-	row := r.database.Pool().QueryRow(ctx, query,
-		u.Email().Value(),
-		u.PublicName().Value(),
+	row := r.database.Querier(ctx).QueryRow(ctx, query,
 		u.Tag().Value(),
+		u.Email().Value(),
 		u.Password().Value(),
+		u.Status(),
+		u.IsVerified(),
+		u.IsPremium(),
+		u.EmailVerifiedAt(),
+		u.PremiumUntil(),
+		u.SuspendedUntil(),
+		u.DeletedAt(),
+		u.AnonymizedAt(),
+		u.Language(),
 		u.LastActiveAt(),
+		u.LastLoginAt(),
 	)
 
 	user, err := scanUser(row)
 	if err != nil {
-		return nil, postgreserrors.WrapWithMapper("PostgresUserRepo.Create", err, nil)
+		return nil, postgreserrors.WrapWithMapper("PostgresUserRepo.Create", err, mapUserUniqueViolation)
 	}
 
 	return user, nil
 }
 
 func (r *PostgresSessionRepo) GetByID(ctx context.Context, userID int64) (*user.User, error) {
-	query := `
-		SELECT 
-			id, email, public_name, tag, password, last_active_at, updated_at, created_at
-		FROM users
-		WHERE id = $1
-	`
+	query := `SELECT ` + userColumns + ` FROM users WHERE id = $1`
 
-	row := r.database.Pool().QueryRow(ctx, query, userID)
+	row := r.database.Querier(ctx).QueryRow(ctx, query, userID)
 
 	user, err := scanUser(row)
 	if err != nil {
-		return nil, postgreserrors.WrapWithMapper("PostgresUserRepo.GetByID", err, func(e error) error {
-			if errors.Is(e, pgx.ErrNoRows) {
-				return domainerrors.ErrUserNotFound
-			}
-			return fmt.Errorf("scan error: %w", e)
-		})
+		return nil, postgreserrors.WrapWithMapper("PostgresUserRepo.GetByID", err, mapUserNotFound)
+	}
+
+	return user, nil
+}
+
+func (r *PostgresSessionRepo) LockByID(ctx context.Context, userID int64) (*user.User, error) {
+	query := `SELECT ` + userColumns + ` FROM users WHERE id = $1 FOR UPDATE`
+
+	row := r.database.Querier(ctx).QueryRow(ctx, query, userID)
+
+	user, err := scanUser(row)
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresUserRepo.LockByID", err, mapUserNotFound)
+	}
+
+	return user, nil
+}
+
+func (r *PostgresSessionRepo) GetByTag(ctx context.Context, tag string) (*user.User, error) {
+	query := `SELECT ` + userColumns + ` FROM users WHERE tag = $1`
+
+	row := r.database.Querier(ctx).QueryRow(ctx, query, tag)
+
+	user, err := scanUser(row)
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresUserRepo.GetByTag", err, mapUserNotFound)
+	}
+
+	return user, nil
+}
+
+func (r *PostgresSessionRepo) GetByEmail(ctx context.Context, email string) (*user.User, error) {
+	query := `SELECT ` + userColumns + ` FROM users WHERE email = $1`
+
+	row := r.database.Querier(ctx).QueryRow(ctx, query, email)
+
+	user, err := scanUser(row)
+	if err != nil {
+		return nil, postgreserrors.WrapWithMapper("PostgresUserRepo.GetByEmail", err, mapUserNotFound)
 	}
 
 	return user, nil
@@ -86,44 +127,91 @@ func (r *PostgresSessionRepo) GetByID(ctx context.Context, userID int64) (*user.
 func (r *PostgresSessionRepo) Update(ctx context.Context, u *user.User) (*user.User, error) {
 	query := `
 		UPDATE users SET
-			email = $1,
-			public_name = $2,
-			tag = $3,
-			password = $4,
-			last_active_at = $5,
-			updated_at = $6,
-			created_at = $7
-		WHERE id = $8
-		RETURNING 
-			id, email, public_name, tag, password, last_active_at, updated_at, created_at
-	`
-
-	row := r.database.Pool().QueryRow(ctx, query,
-		u.Email().Value(),
-		u.PublicName().Value(),
+			tag = $1,
+			email = $2,
+			status = $3,
+			is_verified = $4,
+			is_premium = $5,
+			email_verified_at = $6,
+			premium_until = $7,
+			suspended_until = $8,
+			deleted_at = $9,
+			anonymized_at = $10,
+			language = $11,
+			last_active_at = $12,
+			last_login_at = $13,
+			updated_at = NOW()
+		WHERE id = $14
+		RETURNING ` + userColumns
+
+	row := r.database.Querier(ctx).QueryRow(ctx, query,
 		u.Tag().Value(),
-		u.Password().Value(),
+		u.Email().Value(),
+		u.Status(),
+		u.IsVerified(),
+		u.IsPremium(),
+		u.EmailVerifiedAt(),
+		u.PremiumUntil(),
+		u.SuspendedUntil(),
+		u.DeletedAt(),
+		u.AnonymizedAt(),
+		u.Language(),
 		u.LastActiveAt(),
-		u.UpdatedAt(),
-		u.CreatedAt(),
+		u.LastLoginAt(),
 		u.ID(),
 	)
 
 	user, err := scanUser(row)
 	if err != nil {
-		return nil, postgreserrors.WrapWithMapper("PostgresUserRepo.Update", err, nil)
+		return nil, postgreserrors.WrapWithMapper("PostgresUserRepo.Update", err, func(e error) error {
+			if errors.Is(e, pgx.ErrNoRows) {
+				return domainerrors.ErrUserNotFound
+			}
+
+			return mapUserUniqueViolation(e)
+		})
 	}
 
 	return user, nil
 }
 
+func (r *PostgresSessionRepo) UpdateLogin(ctx context.Context, u *user.User) error {
+	query := `UPDATE users SET last_login_at = $1, last_active_at = $2 WHERE id = $3`
+
+	tag, err := r.database.Querier(ctx).Exec(ctx, query, u.LastLoginAt(), u.LastActiveAt(), u.ID())
+	if err != nil {
+		return postgreserrors.WrapWithMapper("PostgresUserRepo.UpdateLogin", err, nil)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return postgreserrors.WrapWithMapper("PostgresUserRepo.UpdateLogin", pgx.ErrNoRows, mapUserNotFound)
+	}
+
+	return nil
+}
+
+func (r *PostgresSessionRepo) UpdatePassword(ctx context.Context, u *user.User) error {
+	query := `UPDATE users SET password = $1, updated_at = NOW() WHERE id = $2`
+
+	tag, err := r.database.Querier(ctx).Exec(ctx, query, u.Password().Value(), u.ID())
+	if err != nil {
+		return postgreserrors.WrapWithMapper("PostgresUserRepo.UpdatePassword", err, nil)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return postgreserrors.WrapWithMapper("PostgresUserRepo.UpdatePassword", pgx.ErrNoRows, mapUserNotFound)
+	}
+
+	return nil
+}
+
 func (r *PostgresSessionRepo) Find(ctx context.Context, criteria *user.Criteria) ([]*user.User, error) {
 	query, args, err := r.queryFactory.BuildQuery(criteria)
 	if err != nil {
 		return nil, postgreserrors.WrapWithMapper("PostgresUserRepo.Find query builder", err, nil)
 	}
 
-	rows, err := r.database.Pool().Query(ctx, query, args...)
+	rows, err := r.database.Querier(ctx).Query(ctx, query, args...)
 	if err != nil {
 		return nil, postgreserrors.WrapWithMapper("PostgresUserRepo.Find query", err, nil)
 	}
@@ -146,25 +234,67 @@ func (r *PostgresSessionRepo) Find(ctx context.Context, criteria *user.Criteria)
 	return users, nil
 }
 
+func mapUserNotFound(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domainerrors.ErrUserNotFound
+	}
+
+	return fmt.Errorf("scan error: %w", err)
+}
+
+func mapUserUniqueViolation(err error) error {
+	constraint, ok := postgreserrors.UniqueViolation(err)
+	if !ok {
+		return err
+	}
+
+	switch constraint {
+	case "users_email_uidx":
+		return domainerrors.ErrEmailUnavailable
+	case "users_tag_uidx":
+		return domainerrors.ErrUsernameUnavailable
+	default:
+		return domainerrors.ErrUserAlreadyExists
+	}
+}
+
 func scanUser(row pgx.Row) (*user.User, error) {
 	var (
-		id           int64
-		emailStr     string
-		publicName   string
-		tagStr       string
-		passwordStr  string
-		lastActiveAt time.Time
-		updatedAt    time.Time
-		createdAt    time.Time
+		id              int64
+		tagStr          string
+		emailStr        string
+		passwordStr     string
+		status          string
+		isVerified      bool
+		isPremium       bool
+		emailVerifiedAt *time.Time
+		premiumUntil    *time.Time
+		suspendedUntil  *time.Time
+		deletedAt       *time.Time
+		anonymizedAt    *time.Time
+		language        string
+		lastActiveAt    time.Time
+		lastLoginAt     *time.Time
+		updatedAt       time.Time
+		createdAt       time.Time
 	)
 
 	err := row.Scan(
 		&id,
-		&emailStr,
-		&publicName,
 		&tagStr,
+		&emailStr,
 		&passwordStr,
+		&status,
+		&isVerified,
+		&isPremium,
+		&emailVerifiedAt,
+		&premiumUntil,
+		&suspendedUntil,
+		&deletedAt,
+		&anonymizedAt,
+		&language,
 		&lastActiveAt,
+		&lastLoginAt,
 		&updatedAt,
 		&createdAt,
 	)
@@ -173,7 +303,6 @@ func scanUser(row pgx.Row) (*user.User, error) {
 	}
 
 	emailVO, _ := email.New(emailStr)
-	publicNameVO, _ := publicname.New(publicName)
 	tagVO, _ := tag.New(tagStr)
 	passwordVO := password.NewHashedPassword(passwordStr)
 
@@ -181,11 +310,20 @@ func scanUser(row pgx.Row) (*user.User, error) {
 
 	return builder.
 		WithID(id).
-		WithEmail(emailVO).
-		WithPublicName(publicNameVO).
 		WithTag(tagVO).
+		WithEmail(emailVO).
 		WithPassword(passwordVO).
+		WithStatus(enums.UserStatus(status)).
+		WithIsVerified(isVerified).
+		WithIsPremium(isPremium).
+		WithEmailVerifiedAt(emailVerifiedAt).
+		WithPremiumUntil(premiumUntil).
+		WithSuspendedUntil(suspendedUntil).
+		WithDeletedAt(deletedAt).
+		WithAnonymizedAt(anonymizedAt).
+		WithLanguage(language).
 		WithLastActiveAt(lastActiveAt).
+		WithLastLoginAt(lastLoginAt).
 		WithUpdatedAt(updatedAt).
 		WithCreatedAt(createdAt).
 		Build(), nil
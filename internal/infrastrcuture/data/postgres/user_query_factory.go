@@ -24,11 +24,20 @@ func (f *userQueryFactory) BuildQuery(criteria *user.Criteria) (string, []interf
 	query := f.psql.
 		Select(
 			"id",
-			"email",
-			"public_name",
 			"tag",
+			"email",
 			"password",
+			"status",
+			"is_verified",
+			"is_premium",
+			"email_verified_at",
+			"premium_until",
+			"suspended_until",
+			"deleted_at",
+			"anonymized_at",
+			"language",
 			"last_active_at",
+			"last_login_at",
 			"updated_at",
 			"created_at",
 		).
@@ -46,6 +55,38 @@ func (f *userQueryFactory) applyFilters(query squirrel.SelectBuilder, criteria *
 		return query
 	}
 
+	if criteria.IDAfter != nil {
+		query = query.Where(squirrel.Gt{"id": *criteria.IDAfter})
+	}
+
+	if criteria.Status != nil {
+		query = query.Where(squirrel.Eq{"status": string(*criteria.Status)})
+	}
+
+	if criteria.SuspendedUntilBefore != nil {
+		query = query.Where(squirrel.LtOrEq{"suspended_until": *criteria.SuspendedUntilBefore})
+	}
+
+	if criteria.DeletedBefore != nil {
+		query = query.Where(squirrel.LtOrEq{"deleted_at": *criteria.DeletedBefore})
+	}
+
+	if criteria.Anonymized != nil {
+		if *criteria.Anonymized {
+			query = query.Where(squirrel.NotEq{"anonymized_at": nil})
+		} else {
+			query = query.Where(squirrel.Eq{"anonymized_at": nil})
+		}
+	}
+
+	if criteria.IsPremium != nil {
+		query = query.Where(squirrel.Eq{"is_premium": *criteria.IsPremium})
+	}
+
+	if criteria.PremiumUntilBefore != nil {
+		query = query.Where(squirrel.LtOrEq{"premium_until": *criteria.PremiumUntilBefore})
+	}
+
 	if criteria.Email != nil {
 		query = query.Where(squirrel.Eq{"email": *criteria.Email})
 	}
@@ -55,7 +96,7 @@ func (f *userQueryFactory) applyFilters(query squirrel.SelectBuilder, criteria *
 	}
 
 	if criteria.PublicName != nil {
-		query = query.Where(squirrel.Eq{"public_name": *criteria.PublicName})
+		query = query.Where("id IN (SELECT user_id FROM profiles WHERE public_name = ?)", *criteria.PublicName)
 	}
 
 	if criteria.LastActiveBefore != nil {
@@ -82,5 +123,9 @@ func (f *userQueryFactory) applyFilters(query squirrel.SelectBuilder, criteria *
 		query = query.Where(squirrel.GtOrEq{"created_at": *criteria.CreatedAfter})
 	}
 
+	if criteria.Limit > 0 {
+		query = query.OrderBy("id").Limit(criteria.Limit)
+	}
+
 	return query
 }
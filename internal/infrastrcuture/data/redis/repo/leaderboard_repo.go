@@ -0,0 +1,228 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	goredis "github.com/redis/go-redis/v9"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/redis"
+)
+
+// updateRatingScript writes a rating unless the stored copy is newer, so writes reordered after their
+// transactions committed cannot bring back an outdated rating. Provisional ratings are taken off the set but
+// their version is kept all the same.
+// KEYS: sorted set, versions hash. ARGV: rating, user id, version, provisional (1 or 0).
+var updateRatingScript = goredis.NewScript(`
+local current = redis.call('HGET', KEYS[2], ARGV[2])
+if current and tonumber(current) > tonumber(ARGV[3]) then
+	return 0
+end
+if ARGV[4] == '1' then
+	redis.call('ZREM', KEYS[1], ARGV[2])
+else
+	redis.call('ZADD', KEYS[1], ARGV[1], ARGV[2])
+end
+redis.call('HSET', KEYS[2], ARGV[2], ARGV[3])
+return 1
+`)
+
+// RedisLeaderboard keeps one sorted set per time control with user ids as members and ratings as scores,
+// next to a hash of the rating versions (updated_at in microseconds) they were written from. Provisional ratings
+// are left out of the sets.
+type RedisLeaderboard struct {
+	database *redis.Database
+	prefix   string
+}
+
+var _ user.Leaderboard = new(RedisLeaderboard)
+
+func NewRedisLeaderboard(db *redis.Database, prefix string) *RedisLeaderboard {
+	return &RedisLeaderboard{
+		database: db,
+		prefix:   prefix,
+	}
+}
+
+func (l *RedisLeaderboard) Update(ctx context.Context, ratings ...*user.Rating) error {
+	if len(ratings) == 0 {
+		return nil
+	}
+
+	_, err := l.database.Client().Pipelined(ctx, func(pipe goredis.Pipeliner) error {
+		for _, r := range ratings {
+			updateRatingScript.Eval(ctx, pipe,
+				[]string{l.setKey(r.TimeControl), l.versionsKey(r.TimeControl)},
+				r.Rating, r.UserID, r.UpdatedAt.UnixMicro(), provisionalFlag(r),
+			)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("RedisLeaderboard.Update: %w", err)
+	}
+
+	return nil
+}
+
+func (l *RedisLeaderboard) Remove(ctx context.Context, userID int64) error {
+	_, err := l.database.Client().Pipelined(ctx, func(pipe goredis.Pipeliner) error {
+		for _, timeControl := range enums.TimeControls {
+			pipe.ZRem(ctx, l.setKey(timeControl), member(userID))
+			pipe.HDel(ctx, l.versionsKey(timeControl), member(userID))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("RedisLeaderboard.Remove: %w", err)
+	}
+
+	return nil
+}
+
+// Page resolves the cursor to a position in the set. When the cursor's player is gone, the page starts after
+// every player rated above the cursor, which may repeat some of its ties but never skips anyone.
+func (l *RedisLeaderboard) Page(
+	ctx context.Context,
+	timeControl enums.TimeControl,
+	after *user.LeaderboardCursor,
+	limit int,
+) ([]*user.LeaderboardEntry, error) {
+	var start int64
+
+	if after != nil {
+		position, err := l.database.Client().ZRevRank(ctx, l.setKey(timeControl), member(after.UserID)).Result()
+
+		switch {
+		case err == nil:
+			start = position + 1
+		case errors.Is(err, goredis.Nil):
+			start, err = l.countAbove(ctx, timeControl, after.Rating)
+			if err != nil {
+				return nil, fmt.Errorf("RedisLeaderboard.Page: %w", err)
+			}
+		default:
+			return nil, fmt.Errorf("RedisLeaderboard.Page: %w", err)
+		}
+	}
+
+	entries, err := l.rangeFrom(ctx, timeControl, start, limit)
+	if err != nil {
+		return nil, fmt.Errorf("RedisLeaderboard.Page: %w", err)
+	}
+
+	return entries, nil
+}
+
+func (l *RedisLeaderboard) Around(
+	ctx context.Context,
+	timeControl enums.TimeControl,
+	userID int64,
+	radius int,
+) ([]*user.LeaderboardEntry, error) {
+	position, err := l.database.Client().ZRevRank(ctx, l.setKey(timeControl), member(userID)).Result()
+	if errors.Is(err, goredis.Nil) {
+		return nil, domainerrors.ErrRatingNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("RedisLeaderboard.Around: %w", err)
+	}
+
+	start := max(position-int64(radius), 0)
+
+	entries, err := l.rangeFrom(ctx, timeControl, start, int(position-start)+radius+1)
+	if err != nil {
+		return nil, fmt.Errorf("RedisLeaderboard.Around: %w", err)
+	}
+
+	return entries, nil
+}
+
+func (l *RedisLeaderboard) Rank(ctx context.Context, timeControl enums.TimeControl, userID int64) (int, error) {
+	score, err := l.database.Client().ZScore(ctx, l.setKey(timeControl), member(userID)).Result()
+	if errors.Is(err, goredis.Nil) {
+		return 0, domainerrors.ErrRatingNotFound
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("RedisLeaderboard.Rank: %w", err)
+	}
+
+	above, err := l.countAbove(ctx, timeControl, int(score))
+	if err != nil {
+		return 0, fmt.Errorf("RedisLeaderboard.Rank: %w", err)
+	}
+
+	return int(above) + 1, nil
+}
+
+func (l *RedisLeaderboard) rangeFrom(
+	ctx context.Context,
+	timeControl enums.TimeControl,
+	start int64,
+	limit int,
+) ([]*user.LeaderboardEntry, error) {
+	members, err := l.database.Client().ZRevRangeWithScores(ctx, l.setKey(timeControl), start, start+int64(limit)-1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(members) == 0 {
+		return []*user.LeaderboardEntry{}, nil
+	}
+
+	ratings := make([]*user.Rating, 0, len(members))
+
+	for _, m := range members {
+		userID, err := strconv.ParseInt(fmt.Sprint(m.Member), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid leaderboard member %v: %w", m.Member, err)
+		}
+
+		ratings = append(ratings, &user.Rating{
+			UserID:      userID,
+			TimeControl: timeControl,
+			Rating:      int(m.Score),
+		})
+	}
+
+	above, err := l.countAbove(ctx, timeControl, ratings[0].Rating)
+	if err != nil {
+		return nil, err
+	}
+
+	return user.RankLeaderboard(ratings, int(above), int(start)), nil
+}
+
+// countAbove counts players rated strictly higher than rating in O(log n).
+func (l *RedisLeaderboard) countAbove(ctx context.Context, timeControl enums.TimeControl, rating int) (int64, error) {
+	return l.database.Client().ZCount(ctx, l.setKey(timeControl), "("+strconv.Itoa(rating), "+inf").Result()
+}
+
+func (l *RedisLeaderboard) setKey(timeControl enums.TimeControl) string {
+	return l.prefix + ":" + string(timeControl)
+}
+
+func (l *RedisLeaderboard) versionsKey(timeControl enums.TimeControl) string {
+	return l.prefix + ":" + string(timeControl) + ":versions"
+}
+
+func provisionalFlag(r *user.Rating) int {
+	if r.Provisional {
+		return 1
+	}
+
+	return 0
+}
+
+func member(userID int64) string {
+	return strconv.FormatInt(userID, 10)
+}
@@ -0,0 +1,135 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+)
+
+func TestRedisLeaderboard(t *testing.T) {
+	db := openTestRedis(t)
+	ctx := context.Background()
+
+	newLeaderboard := func(t *testing.T) *RedisLeaderboard {
+		t.Helper()
+
+		prefix := "test_leaderboard_" + uuid.NewString()
+		t.Cleanup(func() {
+			db.Client().Del(ctx, prefix+":blitz", prefix+":blitz:versions")
+		})
+
+		return NewRedisLeaderboard(db, prefix)
+	}
+
+	version := time.Now()
+
+	rating := func(userID int64, value int, updatedAt time.Time) *user.Rating {
+		return &user.Rating{UserID: userID, TimeControl: enums.TimeControlBlitz, Rating: value, UpdatedAt: updatedAt}
+	}
+
+	ranks := func(entries []*user.LeaderboardEntry) map[int64]int {
+		result := make(map[int64]int, len(entries))
+		for _, e := range entries {
+			result[e.Rating.UserID] = e.Rank
+		}
+
+		return result
+	}
+
+	t.Run("should rank with shared ties across pages", func(t *testing.T) {
+		l := newLeaderboard(t)
+
+		require.NoError(t, l.Update(ctx,
+			rating(1, 2000, version),
+			rating(2, 1800, version),
+			rating(3, 1800, version),
+			rating(4, 1500, version),
+		))
+
+		first, err := l.Page(ctx, enums.TimeControlBlitz, nil, 2)
+		require.NoError(t, err)
+		require.Len(t, first, 2)
+
+		second, err := l.Page(ctx, enums.TimeControlBlitz, first[1].Cursor(), 2)
+		require.NoError(t, err)
+		require.Len(t, second, 2)
+
+		all := append(first, second...)
+		assert.Equal(t, []int{1, 2, 2, 4}, []int{all[0].Rank, all[1].Rank, all[2].Rank, all[3].Rank})
+
+		rank, err := l.Rank(ctx, enums.TimeControlBlitz, 3)
+		require.NoError(t, err)
+		assert.Equal(t, 2, rank)
+
+		around, err := l.Around(ctx, enums.TimeControlBlitz, 4, 1)
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]int{4: 4, all[2].Rating.UserID: 2}, ranks(around))
+	})
+
+	t.Run("should ignore an outdated rating", func(t *testing.T) {
+		l := newLeaderboard(t)
+
+		require.NoError(t, l.Update(ctx, rating(1, 1700, version)))
+		require.NoError(t, l.Update(ctx, rating(1, 1600, version.Add(-time.Second))))
+
+		entries, err := l.Page(ctx, enums.TimeControlBlitz, nil, 10)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, 1700, entries[0].Rating.Rating)
+	})
+
+	t.Run("should report missing players", func(t *testing.T) {
+		l := newLeaderboard(t)
+
+		_, err := l.Rank(ctx, enums.TimeControlBlitz, 42)
+		assert.ErrorIs(t, err, domainerrors.ErrRatingNotFound)
+
+		_, err = l.Around(ctx, enums.TimeControlBlitz, 42, 5)
+		assert.ErrorIs(t, err, domainerrors.ErrRatingNotFound)
+	})
+
+	t.Run("should leave provisional ratings out", func(t *testing.T) {
+		l := newLeaderboard(t)
+
+		require.NoError(t, l.Update(ctx, rating(1, 1500, version)))
+
+		provisional := rating(1, 1600, version.Add(time.Second))
+		provisional.Provisional = true
+		require.NoError(t, l.Update(ctx, provisional, rating(2, 1400, version)))
+
+		_, err := l.Rank(ctx, enums.TimeControlBlitz, 1)
+		assert.ErrorIs(t, err, domainerrors.ErrRatingNotFound)
+
+		rank, err := l.Rank(ctx, enums.TimeControlBlitz, 2)
+		require.NoError(t, err)
+		assert.Equal(t, 1, rank)
+	})
+
+	t.Run("should remove a user and accept their ratings again", func(t *testing.T) {
+		l := newLeaderboard(t)
+
+		require.NoError(t, l.Update(ctx, rating(1, 2000, version), rating(2, 1800, version)))
+		require.NoError(t, l.Remove(ctx, 1))
+
+		_, err := l.Rank(ctx, enums.TimeControlBlitz, 1)
+		require.ErrorIs(t, err, domainerrors.ErrRatingNotFound)
+
+		rank, err := l.Rank(ctx, enums.TimeControlBlitz, 2)
+		require.NoError(t, err)
+		assert.Equal(t, 1, rank)
+
+		require.NoError(t, l.Update(ctx, rating(1, 2000, version)))
+
+		rank, err = l.Rank(ctx, enums.TimeControlBlitz, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 1, rank)
+	})
+}
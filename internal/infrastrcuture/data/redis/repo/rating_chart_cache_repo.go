@@ -0,0 +1,80 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/postgres"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/redis"
+)
+
+const ratingChartCachePrefix = "rating_chart"
+
+// CachedRatingChartRepo caches the recent rating chart of a user in front of user.RatingRepository. Entries are
+// keyed by the version of the rating and the current day, so any rating change or a new day starts a new entry
+// and nothing needs to be invalidated. Redis failures fall through to the wrapped repository.
+type CachedRatingChartRepo struct {
+	user.RatingRepository
+
+	database *redis.Database
+	ttl      time.Duration
+}
+
+var _ user.RatingRepository = new(CachedRatingChartRepo)
+
+func NewCachedRatingChartRepository(next user.RatingRepository, db *redis.Database, ttl time.Duration) *CachedRatingChartRepo {
+	return &CachedRatingChartRepo{
+		RatingRepository: next,
+		database:         db,
+		ttl:              ttl,
+	}
+}
+
+func (r *CachedRatingChartRepo) GetRecentRatingBuckets(
+	ctx context.Context,
+	userID int64,
+	timeControl enums.TimeControl,
+	days int,
+) ([]*user.RatingBucket, error) {
+	if postgres.InTx(ctx) {
+		return r.RatingRepository.GetRecentRatingBuckets(ctx, userID, timeControl, days)
+	}
+
+	rating, err := r.RatingRepository.GetUserRating(ctx, userID, timeControl)
+	if err != nil {
+		// Without a rating version there is nothing to key an entry by.
+		return r.RatingRepository.GetRecentRatingBuckets(ctx, userID, timeControl, days)
+	}
+
+	key := fmt.Sprintf("%s:%d:%s:%d:%d:%s",
+		ratingChartCachePrefix,
+		userID,
+		timeControl,
+		days,
+		rating.UpdatedAt.UnixNano(),
+		time.Now().UTC().Format(time.DateOnly),
+	)
+
+	if data, err := r.database.Client().Get(ctx, key).Bytes(); err == nil {
+		var buckets []*user.RatingBucket
+		if err = json.Unmarshal(data, &buckets); err == nil {
+			return buckets, nil
+		}
+	}
+
+	buckets, err := r.RatingRepository.GetRecentRatingBuckets(ctx, userID, timeControl, days)
+	if err != nil {
+		return nil, err
+	}
+
+	if data, err := json.Marshal(buckets); err == nil {
+		// Best effort: a failed write only costs another query.
+		_ = r.database.Client().Set(ctx, key, data, r.ttl).Err()
+	}
+
+	return buckets, nil
+}
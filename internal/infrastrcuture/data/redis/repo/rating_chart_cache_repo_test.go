@@ -0,0 +1,68 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+)
+
+type countingRatingRepo struct {
+	user.RatingRepository
+
+	rating *user.Rating
+	calls  int
+}
+
+func (r *countingRatingRepo) GetUserRating(_ context.Context, _ int64, _ enums.TimeControl) (*user.Rating, error) {
+	return r.rating, nil
+}
+
+func (r *countingRatingRepo) GetRecentRatingBuckets(
+	_ context.Context,
+	_ int64,
+	_ enums.TimeControl,
+	_ int,
+) ([]*user.RatingBucket, error) {
+	r.calls++
+
+	return []*user.RatingBucket{{
+		Start: time.Now().UTC().Truncate(24 * time.Hour),
+		Open:  1500,
+		Close: 1512,
+		Min:   1500,
+		Max:   1512,
+		Games: 1,
+	}}, nil
+}
+
+func TestCachedRatingChartRepo(t *testing.T) {
+	db := openTestRedis(t)
+	ctx := context.Background()
+
+	t.Run("should reuse the chart until the rating changes", func(t *testing.T) {
+		next := &countingRatingRepo{rating: &user.Rating{UpdatedAt: time.Now()}}
+		cache := NewCachedRatingChartRepository(next, db, time.Minute)
+
+		first, err := cache.GetRecentRatingBuckets(ctx, 2001, enums.TimeControlBlitz, 90)
+		require.NoError(t, err)
+
+		second, err := cache.GetRecentRatingBuckets(ctx, 2001, enums.TimeControlBlitz, 90)
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, next.calls)
+		assert.Equal(t, first[0].Close, second[0].Close)
+		assert.True(t, first[0].Start.Equal(second[0].Start))
+
+		next.rating = &user.Rating{UpdatedAt: time.Now().Add(time.Second)}
+
+		_, err = cache.GetRecentRatingBuckets(ctx, 2001, enums.TimeControlBlitz, 90)
+		require.NoError(t, err)
+		assert.Equal(t, 2, next.calls)
+	})
+}
@@ -0,0 +1,31 @@
+package repo
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/redis"
+)
+
+// testRedisURLEnv points integration tests at a disposable Redis database, e.g. redis://localhost:6379/15.
+const testRedisURLEnv = "TEST_REDIS_URL"
+
+func openTestRedis(t *testing.T) *redis.Database {
+	t.Helper()
+
+	url := os.Getenv(testRedisURLEnv)
+	if url == "" {
+		t.Skipf("%s is not set", testRedisURLEnv)
+	}
+
+	ctx := context.Background()
+
+	db, err := redis.New(ctx, url)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Shutdown(ctx) })
+
+	return db
+}
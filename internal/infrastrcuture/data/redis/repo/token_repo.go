@@ -0,0 +1,100 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	domainerrors "github.com/EugeneTsydenov/chesshub-user-service/internal/domain/errors"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/redis"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/pkg/tokengen"
+)
+
+// RedisTokenRepo keeps only token digests, so a leaked keyspace cannot be replayed.
+type RedisTokenRepo struct {
+	database *redis.Database
+	prefix   string
+}
+
+var _ user.TokenRepository = new(RedisTokenRepo)
+
+func NewRedisTokenRepository(db *redis.Database, prefix string) *RedisTokenRepo {
+	return &RedisTokenRepo{
+		database: db,
+		prefix:   prefix,
+	}
+}
+
+func (r *RedisTokenRepo) Save(ctx context.Context, token string, userID int64, ttl time.Duration) error {
+	hash := tokengen.Hash(token)
+
+	_, err := r.database.Client().TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.Set(ctx, r.tokenKey(hash), userID, ttl)
+		pipe.SAdd(ctx, r.userKey(userID), hash)
+		pipe.Expire(ctx, r.userKey(userID), ttl)
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("RedisTokenRepo.Save: %w", err)
+	}
+
+	return nil
+}
+
+func (r *RedisTokenRepo) Consume(ctx context.Context, token string) (int64, error) {
+	hash := tokengen.Hash(token)
+
+	raw, err := r.database.Client().GetDel(ctx, r.tokenKey(hash)).Result()
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			return 0, fmt.Errorf("RedisTokenRepo.Consume: %w", domainerrors.ErrInvalidToken)
+		}
+
+		return 0, fmt.Errorf("RedisTokenRepo.Consume: %w", err)
+	}
+
+	userID, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("RedisTokenRepo.Consume: %w", domainerrors.ErrInvalidToken)
+	}
+
+	if err = r.database.Client().SRem(ctx, r.userKey(userID), hash).Err(); err != nil {
+		return 0, fmt.Errorf("RedisTokenRepo.Consume: %w", err)
+	}
+
+	return userID, nil
+}
+
+func (r *RedisTokenRepo) RevokeAll(ctx context.Context, userID int64) error {
+	hashes, err := r.database.Client().SMembers(ctx, r.userKey(userID)).Result()
+	if err != nil {
+		return fmt.Errorf("RedisTokenRepo.RevokeAll: %w", err)
+	}
+
+	keys := make([]string, 0, len(hashes)+1)
+	for _, hash := range hashes {
+		keys = append(keys, r.tokenKey(hash))
+	}
+
+	keys = append(keys, r.userKey(userID))
+
+	if err = r.database.Client().Del(ctx, keys...).Err(); err != nil {
+		return fmt.Errorf("RedisTokenRepo.RevokeAll: %w", err)
+	}
+
+	return nil
+}
+
+func (r *RedisTokenRepo) tokenKey(hash string) string {
+	return fmt.Sprintf("%s:token:%s", r.prefix, hash)
+}
+
+func (r *RedisTokenRepo) userKey(userID int64) string {
+	return fmt.Sprintf("%s:user:%d", r.prefix, userID)
+}
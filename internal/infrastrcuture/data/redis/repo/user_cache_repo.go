@@ -0,0 +1,337 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/email"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/password"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/tag"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/postgres"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/redis"
+)
+
+const userCachePrefix = "user_cache"
+
+type CacheStats struct {
+	Hits   uint64
+	Misses uint64
+}
+
+// CachedUserRepo is a read-through cache in front of user.Repository. Users are stored once under their id,
+// email and tag keys only point at the id, so an update has a single entry to invalidate. Redis failures are
+// never fatal: reads fall through to the wrapped repository. Reads inside a transaction bypass the cache so
+// uncommitted rows are never cached or shared with other callers.
+//
+// Password hashes are not cached, users read from the cache carry an empty one: credential checks must read the
+// wrapped repository. A miss that read the row before a concurrent update may store it after that update
+// invalidated the entry, such a stale user is served until the entry expires, so reads may lag writes by up to
+// the TTL.
+type CachedUserRepo struct {
+	user.Repository
+
+	database *redis.Database
+	ttl      time.Duration
+	group    singleflight.Group
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+var _ user.Repository = new(CachedUserRepo)
+
+func NewCachedUserRepository(next user.Repository, db *redis.Database, ttl time.Duration) *CachedUserRepo {
+	return &CachedUserRepo{
+		Repository: next,
+		database:   db,
+		ttl:        ttl,
+	}
+}
+
+func (r *CachedUserRepo) GetByID(ctx context.Context, id int64) (*user.User, error) {
+	if postgres.InTx(ctx) {
+		return r.Repository.GetByID(ctx, id)
+	}
+
+	if u, ok := r.getCached(ctx, id); ok {
+		r.hits.Add(1)
+
+		return u, nil
+	}
+
+	r.misses.Add(1)
+
+	return r.load(ctx, r.idKey(id), func(ctx context.Context) (*user.User, error) {
+		return r.Repository.GetByID(ctx, id)
+	})
+}
+
+func (r *CachedUserRepo) GetByEmail(ctx context.Context, emailValue string) (*user.User, error) {
+	if postgres.InTx(ctx) {
+		return r.Repository.GetByEmail(ctx, emailValue)
+	}
+
+	key := r.emailKey(emailValue)
+
+	if u, ok := r.getIndexed(ctx, key); ok && u.Email().Value() == emailValue {
+		r.hits.Add(1)
+
+		return u, nil
+	}
+
+	r.misses.Add(1)
+
+	return r.load(ctx, key, func(ctx context.Context) (*user.User, error) {
+		return r.Repository.GetByEmail(ctx, emailValue)
+	})
+}
+
+func (r *CachedUserRepo) GetByTag(ctx context.Context, tagValue string) (*user.User, error) {
+	if postgres.InTx(ctx) {
+		return r.Repository.GetByTag(ctx, tagValue)
+	}
+
+	key := r.tagKey(tagValue)
+
+	if u, ok := r.getIndexed(ctx, key); ok && u.Tag().Value() == tagValue {
+		r.hits.Add(1)
+
+		return u, nil
+	}
+
+	r.misses.Add(1)
+
+	return r.load(ctx, key, func(ctx context.Context) (*user.User, error) {
+		return r.Repository.GetByTag(ctx, tagValue)
+	})
+}
+
+// Update invalidates the cached user right after the write and again once the surrounding transaction commits,
+// so a concurrent miss cannot keep the pre-commit row cached. Index keys of a previous email or tag are left to
+// expire, lookups through them are rejected because they no longer match the cached user.
+func (r *CachedUserRepo) Update(ctx context.Context, u *user.User) (*user.User, error) {
+	updated, err := r.Repository.Update(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.invalidateWritten(ctx, updated.ID()); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (r *CachedUserRepo) UpdateLogin(ctx context.Context, u *user.User) error {
+	if err := r.Repository.UpdateLogin(ctx, u); err != nil {
+		return err
+	}
+
+	return r.invalidateWritten(ctx, u.ID())
+}
+
+func (r *CachedUserRepo) Invalidate(ctx context.Context, id int64) error {
+	if err := r.database.Client().Del(ctx, r.idKey(id)).Err(); err != nil {
+		return fmt.Errorf("CachedUserRepo.Invalidate: %w", err)
+	}
+
+	return nil
+}
+
+func (r *CachedUserRepo) UpdatePassword(ctx context.Context, u *user.User) error {
+	if err := r.Repository.UpdatePassword(ctx, u); err != nil {
+		return err
+	}
+
+	return r.invalidateWritten(ctx, u.ID())
+}
+
+// invalidateWritten drops the user written by the caller, again after commit when the write is transactional.
+func (r *CachedUserRepo) invalidateWritten(ctx context.Context, id int64) error {
+	if err := r.Invalidate(ctx, id); err != nil {
+		return err
+	}
+
+	if postgres.InTx(ctx) {
+		postgres.AfterCommit(ctx, func(ctx context.Context) {
+			_ = r.Invalidate(ctx, id)
+		})
+	}
+
+	return nil
+}
+
+func (r *CachedUserRepo) Stats() CacheStats {
+	return CacheStats{
+		Hits:   r.hits.Load(),
+		Misses: r.misses.Load(),
+	}
+}
+
+// load collapses concurrent misses for the same key into one repository call and caches its result.
+func (r *CachedUserRepo) load(
+	ctx context.Context,
+	key string,
+	fetch func(ctx context.Context) (*user.User, error),
+) (*user.User, error) {
+	result, err, _ := r.group.Do(key, func() (any, error) {
+		// The shared call must not be cancelled because the caller that started it went away.
+		ctx := context.WithoutCancel(ctx)
+
+		u, err := fetch(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		r.store(ctx, u)
+
+		return u, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*user.User), nil
+}
+
+func (r *CachedUserRepo) getCached(ctx context.Context, id int64) (*user.User, bool) {
+	data, err := r.database.Client().Get(ctx, r.idKey(id)).Bytes()
+	if err != nil {
+		return nil, false
+	}
+
+	var cached cachedUser
+	if err = json.Unmarshal(data, &cached); err != nil {
+		return nil, false
+	}
+
+	u, err := cached.toUser()
+	if err != nil {
+		return nil, false
+	}
+
+	return u, true
+}
+
+func (r *CachedUserRepo) getIndexed(ctx context.Context, key string) (*user.User, bool) {
+	id, err := r.database.Client().Get(ctx, key).Int64()
+	if err != nil {
+		return nil, false
+	}
+
+	return r.getCached(ctx, id)
+}
+
+func (r *CachedUserRepo) store(ctx context.Context, u *user.User) {
+	data, err := json.Marshal(fromUser(u))
+	if err != nil {
+		return
+	}
+
+	// Best effort: a failed write only costs another miss.
+	_, _ = r.database.Client().Pipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.Set(ctx, r.idKey(u.ID()), data, r.ttl)
+		pipe.Set(ctx, r.emailKey(u.Email().Value()), u.ID(), r.ttl)
+		pipe.Set(ctx, r.tagKey(u.Tag().Value()), u.ID(), r.ttl)
+
+		return nil
+	})
+}
+
+func (r *CachedUserRepo) idKey(id int64) string {
+	return userCachePrefix + ":id:" + strconv.FormatInt(id, 10)
+}
+
+func (r *CachedUserRepo) emailKey(emailValue string) string {
+	return userCachePrefix + ":email:" + emailValue
+}
+
+func (r *CachedUserRepo) tagKey(tagValue string) string {
+	return userCachePrefix + ":tag:" + tagValue
+}
+
+type cachedUser struct {
+	ID              int64      `json:"id"`
+	Tag             string     `json:"tag"`
+	Email           string     `json:"email"`
+	Status          string     `json:"status"`
+	IsVerified      bool       `json:"is_verified"`
+	IsPremium       bool       `json:"is_premium"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+	PremiumUntil    *time.Time `json:"premium_until,omitempty"`
+	SuspendedUntil  *time.Time `json:"suspended_until,omitempty"`
+	DeletedAt       *time.Time `json:"deleted_at,omitempty"`
+	AnonymizedAt    *time.Time `json:"anonymized_at,omitempty"`
+	Language        string     `json:"language"`
+	LastActiveAt    time.Time  `json:"last_active_at"`
+	LastLoginAt     *time.Time `json:"last_login_at,omitempty"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+func fromUser(u *user.User) *cachedUser {
+	return &cachedUser{
+		ID:              u.ID(),
+		Tag:             u.Tag().Value(),
+		Email:           u.Email().Value(),
+		Status:          string(u.Status()),
+		IsVerified:      u.IsVerified(),
+		IsPremium:       u.IsPremium(),
+		EmailVerifiedAt: u.EmailVerifiedAt(),
+		PremiumUntil:    u.PremiumUntil(),
+		SuspendedUntil:  u.SuspendedUntil(),
+		DeletedAt:       u.DeletedAt(),
+		AnonymizedAt:    u.AnonymizedAt(),
+		Language:        u.Language(),
+		LastActiveAt:    u.LastActiveAt(),
+		LastLoginAt:     u.LastLoginAt(),
+		UpdatedAt:       u.UpdatedAt(),
+		CreatedAt:       u.CreatedAt(),
+	}
+}
+
+func (c *cachedUser) toUser() (*user.User, error) {
+	emailVO, err := email.New(c.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	tagVO, err := tag.New(c.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.ID == 0 {
+		return nil, errors.New("cached user has no id")
+	}
+
+	return user.NewBuilder().
+		WithID(c.ID).
+		WithTag(tagVO).
+		WithEmail(emailVO).
+		WithPassword(password.NewHashedPassword("")).
+		WithStatus(enums.UserStatus(c.Status)).
+		WithIsVerified(c.IsVerified).
+		WithIsPremium(c.IsPremium).
+		WithEmailVerifiedAt(c.EmailVerifiedAt).
+		WithPremiumUntil(c.PremiumUntil).
+		WithSuspendedUntil(c.SuspendedUntil).
+		WithDeletedAt(c.DeletedAt).
+		WithAnonymizedAt(c.AnonymizedAt).
+		WithLanguage(c.Language).
+		WithLastActiveAt(c.LastActiveAt).
+		WithLastLoginAt(c.LastLoginAt).
+		WithUpdatedAt(c.UpdatedAt).
+		WithCreatedAt(c.CreatedAt).
+		Build(), nil
+}
@@ -0,0 +1,133 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/entity/user"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/enums"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/email"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/password"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/domain/valueobjects/tag"
+)
+
+type countingRepo struct {
+	user.Repository
+
+	user  *user.User
+	calls atomic.Int32
+}
+
+func (r *countingRepo) GetByID(_ context.Context, _ int64) (*user.User, error) {
+	r.calls.Add(1)
+	time.Sleep(20 * time.Millisecond)
+
+	return r.user, nil
+}
+
+func (r *countingRepo) Update(_ context.Context, u *user.User) (*user.User, error) {
+	r.user = u
+
+	return u, nil
+}
+
+func newTestUser(t *testing.T, id int64) *user.User {
+	t.Helper()
+
+	emailVO, err := email.New("player@example.com")
+	require.NoError(t, err)
+
+	tagVO, err := tag.New("player01")
+	require.NoError(t, err)
+
+	verifiedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	return user.NewBuilder().
+		WithID(id).
+		WithTag(tagVO).
+		WithEmail(emailVO).
+		WithPassword(password.NewHashedPassword("hash")).
+		WithStatus(enums.UserStatusActive).
+		WithIsVerified(true).
+		WithEmailVerifiedAt(&verifiedAt).
+		WithLanguage("en").
+		WithLastActiveAt(verifiedAt).
+		WithUpdatedAt(verifiedAt).
+		WithCreatedAt(verifiedAt).
+		Build()
+}
+
+func TestCachedUser(t *testing.T) {
+	t.Run("should restore the same user it was built from but its password", func(t *testing.T) {
+		u := newTestUser(t, 42)
+
+		restored, err := fromUser(u).toUser()
+		require.NoError(t, err)
+		assert.Empty(t, restored.Password().Value())
+
+		restored.ChangePassword(u.Password())
+		assert.Equal(t, u, restored)
+	})
+
+	t.Run("should not serialise the password hash", func(t *testing.T) {
+		data, err := json.Marshal(fromUser(newTestUser(t, 42)))
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "password")
+	})
+}
+
+func TestCachedUserRepo(t *testing.T) {
+	db := openTestRedis(t)
+	ctx := context.Background()
+
+	t.Run("should load a missing user once for concurrent callers", func(t *testing.T) {
+		next := &countingRepo{user: newTestUser(t, 1001)}
+		cache := NewCachedUserRepository(next, db, time.Minute)
+		require.NoError(t, cache.Invalidate(ctx, 1001))
+
+		var wg sync.WaitGroup
+
+		for range 10 {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				_, err := cache.GetByID(ctx, 1001)
+				assert.NoError(t, err)
+			}()
+		}
+
+		wg.Wait()
+
+		assert.Equal(t, int32(1), next.calls.Load())
+		assert.Equal(t, uint64(10), cache.Stats().Misses)
+
+		_, err := cache.GetByID(ctx, 1001)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(1), cache.Stats().Hits)
+	})
+
+	t.Run("should reload the user after an update", func(t *testing.T) {
+		next := &countingRepo{user: newTestUser(t, 1002)}
+		cache := NewCachedUserRepository(next, db, time.Minute)
+		require.NoError(t, cache.Invalidate(ctx, 1002))
+
+		_, err := cache.GetByID(ctx, 1002)
+		require.NoError(t, err)
+
+		_, err = cache.Update(ctx, newTestUser(t, 1002))
+		require.NoError(t, err)
+
+		_, err = cache.GetByID(ctx, 1002)
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), next.calls.Load())
+	})
+}
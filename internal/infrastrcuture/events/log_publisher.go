@@ -0,0 +1,35 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/outbox"
+)
+
+// LogPublisher writes messages to stdout instead of a broker. Intended for local runs.
+type LogPublisher struct {
+	mu     sync.Mutex
+	writer io.Writer
+}
+
+var _ outbox.EventPublisher = new(LogPublisher)
+
+func NewLogPublisher() *LogPublisher {
+	return &LogPublisher{writer: os.Stdout}
+}
+
+func (p *LogPublisher) Publish(_ context.Context, msg *outbox.Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	_, err := fmt.Fprintf(p.writer, "event %s %s user=%d %s\n", msg.EventID, msg.EventType, msg.AggregateID, msg.Payload)
+	if err != nil {
+		return fmt.Errorf("write event: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package events
+
+import (
+	"context"
+	"sync"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/outbox"
+)
+
+// MemoryPublisher keeps published messages in memory. Intended for tests.
+type MemoryPublisher struct {
+	mu       sync.Mutex
+	messages []*outbox.Message
+}
+
+var _ outbox.EventPublisher = new(MemoryPublisher)
+
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+func (p *MemoryPublisher) Publish(_ context.Context, msg *outbox.Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.messages = append(p.messages, msg)
+
+	return nil
+}
+
+// Messages returns a copy of the messages published so far, in publishing order.
+func (p *MemoryPublisher) Messages() []*outbox.Message {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]*outbox.Message(nil), p.messages...)
+}
@@ -0,0 +1,68 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/outbox"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/redis"
+)
+
+// RedisStreamPublisher appends messages to Redis Streams. Messages are spread over partitions by aggregate id,
+// so events of one user always land in the same stream, in order, while consumers can scale per partition.
+// Streams are trimmed to roughly maxLen entries.
+type RedisStreamPublisher struct {
+	database   *redis.Database
+	stream     string
+	partitions int
+	maxLen     int64
+}
+
+var _ outbox.EventPublisher = new(RedisStreamPublisher)
+
+func NewRedisStreamPublisher(db *redis.Database, stream string, partitions int, maxLen int64) *RedisStreamPublisher {
+	return &RedisStreamPublisher{
+		database:   db,
+		stream:     stream,
+		partitions: max(partitions, 1),
+		maxLen:     maxLen,
+	}
+}
+
+func (p *RedisStreamPublisher) Publish(ctx context.Context, msg *outbox.Message) error {
+	err := p.database.Client().XAdd(ctx, &goredis.XAddArgs{
+		Stream: p.StreamFor(msg.AggregateID),
+		MaxLen: p.maxLen,
+		Approx: true,
+		Values: map[string]any{
+			"event_id":    msg.EventID.String(),
+			"event_type":  msg.EventType,
+			"key":         strconv.FormatInt(msg.AggregateID, 10),
+			"payload":     string(msg.Payload),
+			"occurred_at": msg.CreatedAt.UTC().Format(time.RFC3339Nano),
+		},
+	}).Err()
+	if err != nil {
+		return fmt.Errorf("RedisStreamPublisher.Publish: %w", err)
+	}
+
+	return nil
+}
+
+// StreamFor returns the stream that receives the events of an aggregate.
+func (p *RedisStreamPublisher) StreamFor(aggregateID int64) string {
+	if p.partitions == 1 {
+		return p.stream
+	}
+
+	partition := aggregateID % int64(p.partitions)
+	if partition < 0 {
+		partition = -partition
+	}
+
+	return p.stream + ":" + strconv.FormatInt(partition, 10)
+}
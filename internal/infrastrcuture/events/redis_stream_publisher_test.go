@@ -0,0 +1,70 @@
+package events
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/outbox"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/redis"
+)
+
+// testRedisURLEnv points integration tests at a disposable Redis database, e.g. redis://localhost:6379/15.
+const testRedisURLEnv = "TEST_REDIS_URL"
+
+func TestRedisStreamPublisher_StreamFor(t *testing.T) {
+	t.Run("should use the base stream without partitions", func(t *testing.T) {
+		p := NewRedisStreamPublisher(nil, "events", 0, 0)
+
+		assert.Equal(t, "events", p.StreamFor(7))
+	})
+
+	t.Run("should keep an aggregate on one partition", func(t *testing.T) {
+		p := NewRedisStreamPublisher(nil, "events", 4, 0)
+
+		assert.Equal(t, "events:3", p.StreamFor(7))
+		assert.Equal(t, p.StreamFor(7), p.StreamFor(7))
+		assert.Equal(t, "events:1", p.StreamFor(-5))
+	})
+}
+
+func TestRedisStreamPublisher_Publish(t *testing.T) {
+	url := os.Getenv(testRedisURLEnv)
+	if url == "" {
+		t.Skipf("%s is not set", testRedisURLEnv)
+	}
+
+	ctx := context.Background()
+
+	db, err := redis.New(ctx, url)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Shutdown(ctx) })
+
+	t.Run("should append messages in order and trim the stream", func(t *testing.T) {
+		stream := "test_events_" + uuid.NewString()
+		t.Cleanup(func() { db.Client().Del(ctx, stream) })
+
+		p := NewRedisStreamPublisher(db, stream, 1, 1)
+
+		for i := range 3 {
+			err := p.Publish(ctx, &outbox.Message{
+				EventID:     uuid.New(),
+				EventType:   "user.registered",
+				AggregateID: int64(i),
+				Payload:     []byte(`{}`),
+				CreatedAt:   time.Now(),
+			})
+			require.NoError(t, err)
+		}
+
+		entries, err := db.Client().XRange(ctx, stream, "-", "+").Result()
+		require.NoError(t, err)
+		require.NotEmpty(t, entries)
+		assert.Equal(t, "2", entries[len(entries)-1].Values["key"])
+	})
+}
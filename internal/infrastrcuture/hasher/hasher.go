@@ -15,7 +15,7 @@ func (h *Hasher) Hash(value string) (string, error) {
 	return string(bytes), err
 }
 
-func (h *Hasher) Compare(hash string, plain string, cost int) error {
+func (h *Hasher) Compare(hash string, plain string) error {
 	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(plain))
 	if err != nil {
 		return err
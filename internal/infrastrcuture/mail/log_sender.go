@@ -0,0 +1,56 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/mail"
+)
+
+// LogSender writes messages to a file or stdout instead of delivering them. Intended for local runs.
+type LogSender struct {
+	mu     sync.Mutex
+	from   string
+	writer io.Writer
+	closer io.Closer
+}
+
+var _ mail.Sender = new(LogSender)
+
+func NewLogSender(from, filePath string) (*LogSender, error) {
+	if filePath == "" {
+		return &LogSender{from: from, writer: os.Stdout}, nil
+	}
+
+	f, err := os.OpenFile(filePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("open mail log file: %w", err)
+	}
+
+	return &LogSender{from: from, writer: f, closer: f}, nil
+}
+
+func (s *LogSender) Send(_ context.Context, msg *mail.Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, err := fmt.Fprintf(s.writer, "----- %s -----\nFrom: %s\nTo: %s\nSubject: %s\n\n%s\n\n",
+		time.Now().Format(time.RFC3339), s.from, msg.To, msg.Subject, msg.Body)
+	if err != nil {
+		return fmt.Errorf("write mail: %w", err)
+	}
+
+	return nil
+}
+
+func (s *LogSender) Shutdown(_ context.Context) error {
+	if s.closer == nil {
+		return nil
+	}
+
+	return s.closer.Close()
+}
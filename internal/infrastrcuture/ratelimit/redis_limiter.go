@@ -0,0 +1,49 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/app/ratelimit"
+	"github.com/EugeneTsydenov/chesshub-user-service/internal/infrastrcuture/data/redis"
+)
+
+// FixedWindowLimiter allows up to limit attempts per key within each window.
+type FixedWindowLimiter struct {
+	database *redis.Database
+	prefix   string
+	limit    int64
+	window   time.Duration
+}
+
+var _ ratelimit.Limiter = new(FixedWindowLimiter)
+
+func NewFixedWindowLimiter(db *redis.Database, prefix string, limit int, window time.Duration) *FixedWindowLimiter {
+	return &FixedWindowLimiter{
+		database: db,
+		prefix:   prefix,
+		limit:    int64(limit),
+		window:   window,
+	}
+}
+
+func (l *FixedWindowLimiter) Allow(ctx context.Context, key string) (bool, error) {
+	redisKey := fmt.Sprintf("%s:%s", l.prefix, key)
+
+	var incr *goredis.IntCmd
+
+	_, err := l.database.Client().TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		incr = pipe.Incr(ctx, redisKey)
+		pipe.ExpireNX(ctx, redisKey, l.window)
+
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("FixedWindowLimiter.Allow: %w", err)
+	}
+
+	return incr.Val() <= l.limit, nil
+}